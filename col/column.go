@@ -1,6 +1,6 @@
 package col
 
-import "fmt"
+import "strings"
 
 // Justification represents how a column is justified
 type Justification int
@@ -23,6 +23,19 @@ type Col struct {
 	f          Formatter
 	finalWidth int
 	sep        string
+	groupDupEq EqualFunc
+
+	overflow       Overflow
+	overflowMarker string
+
+	vAlign     VAlign
+	contFill   ContFill
+	contMarker string
+
+	decAlign bool
+	decFracW int
+
+	hdrNotes []string
 }
 
 // New creates a new Col object
@@ -61,11 +74,19 @@ func (c Col) hdrText(rowIdx, rowCount int) string {
 	return c.headers[valIdx]
 }
 
-// stringInCol returns the string s formatted to fit in the column
+// stringInCol returns the string s formatted to fit in the column. The
+// padding is calculated from the visible width of the string so that any
+// terminal escape sequences are ignored. Decimal-aligned columns are always
+// right-justified.
 func (c Col) stringInCol(s string) string {
-	if c.f.Just() == Left {
-		return fmt.Sprintf("%-*s", c.finalWidth, s)
+	padLen := c.finalWidth - VisibleWidth(s)
+	if padLen <= 0 {
+		return s
+	}
+
+	if c.f.Just() == Left && !c.decAlign {
+		return s + strings.Repeat(" ", padLen)
 	}
 
-	return fmt.Sprintf("%*s", c.finalWidth, s)
+	return strings.Repeat(" ", padLen) + s
 }
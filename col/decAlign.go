@@ -0,0 +1,80 @@
+package col
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SetDecAlign sets the column to align the decimal points of its values.
+// The intW gives the greatest number of characters expected before the
+// decimal point (including any sign) and the fracW gives the greatest
+// number expected after it. Each value is padded on the right so that its
+// decimal point lines up with those of the other values and the column is
+// right-justified regardless of the justification of its Formatter. The
+// column is made wide enough to hold intW+fracW+1 characters. A negative
+// fracW turns decimal alignment off.
+//
+// Values without a decimal point, such as integers or values replaced by
+// the Formatter (for instance, zero or nil replacements), are placed as if
+// they had a decimal point immediately after them. For values in exponent
+// form the exponent is treated as part of the fraction; any value whose
+// fraction (or exponent) is wider than fracW is right-justified in the
+// column. Any footnote marker (see [Noted]) is added after the padding and
+// so is not taken to be part of the fraction.
+func (c *Col) SetDecAlign(intW, fracW int) *Col {
+	c.decAlign = fracW >= 0
+	c.decFracW = fracW
+
+	c.finalWidth = c.f.Width()
+	if c.decAlign {
+		c.finalWidth = max(c.finalWidth, max(intW, 0)+fracW+1)
+	}
+
+	return c
+}
+
+// decPointIdx returns the index of the decimal point in the string. If
+// there is no decimal point then it returns the index of the exponent
+// marker or, if there is none, the length of the string.
+func decPointIdx(s string) int {
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return i
+	}
+
+	seenDigit := false
+
+	for i, r := range s {
+		if unicode.IsDigit(r) {
+			seenDigit = true
+			continue
+		}
+
+		if seenDigit && (r == 'e' || r == 'E') {
+			return i
+		}
+	}
+
+	return len(s)
+}
+
+// decAlignLines pads the lines so that their decimal points are aligned.
+// Blank lines are left unchanged. The lines are only changed if the column
+// is decimal-aligned.
+func (c Col) decAlignLines(lines []string) []string {
+	if !c.decAlign {
+		return lines
+	}
+
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			lines[i] = line
+			continue
+		}
+
+		fracW := VisibleWidth(line[decPointIdx(line):])
+		lines[i] = line + strings.Repeat(" ", max(c.decFracW+1-fracW, 0))
+	}
+
+	return lines
+}
@@ -0,0 +1,93 @@
+package col_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestDecAlign(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		f           col.Formatter
+		intW        int
+		fracW       int
+		vals        []any
+		expectedVal string
+	}{
+		{
+			ID:    testhelper.MkID("mixed precision, %g"),
+			f:     &colfmt.Float{W: 9, Verb: 'g', Prec: 4},
+			fracW: 3,
+			vals:  []any{1.5, 12.25, 100.0, 0.125},
+			expectedVal: "" +
+				"    1.5   |\n" +
+				"   12.25  |\n" +
+				"  100     |\n" +
+				"    0.125 |\n",
+		},
+		{
+			ID:    testhelper.MkID("exponent form"),
+			f:     &colfmt.Float{W: 9, Verb: 'g', Prec: 4},
+			fracW: 3,
+			vals:  []any{1.5, 1e+21, 1.5e-07},
+			expectedVal: "" +
+				"    1.5   |\n" +
+				"    1e+21 |\n" +
+				"  1.5e-07 |\n",
+		},
+		{
+			ID:    testhelper.MkID("left-justified formatter"),
+			f:     &colfmt.String{W: 6},
+			fracW: 2,
+			vals:  []any{"1.5", "22", "n/a"},
+			expectedVal: "" +
+				"  1.5  |\n" +
+				" 22    |\n" +
+				"n/a    |\n",
+		},
+		{
+			ID:    testhelper.MkID("alignment off"),
+			f:     &colfmt.String{W: 6},
+			fracW: -1,
+			vals:  []any{"1.5", "22"},
+			expectedVal: "" +
+				"1.5    |\n" +
+				"22     |\n",
+		},
+		{
+			ID:    testhelper.MkID("column widened for the padding"),
+			f:     &colfmt.Float{W: 5, Prec: 2},
+			intW:  2,
+			fracW: 3,
+			vals:  []any{12.34, 1.5},
+			expectedVal: "" +
+				"12.34  |\n" +
+				" 1.50  |\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+			&b,
+			col.New(tc.f).SetDecAlign(tc.intW, tc.fracW),
+			col.New(&colfmt.String{W: 1}))
+
+		for _, v := range tc.vals {
+			if err := rpt.PrintRow(v, "|"); err != nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: unexpected error: %s", err)
+			}
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "report",
+			b.String(), tc.expectedVal)
+		testhelper.DiffInt(t, tc.IDStr(), "overflow count",
+			rpt.OverflowCount(), 0)
+	}
+}
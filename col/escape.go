@@ -0,0 +1,141 @@
+package col
+
+import (
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nickwells/col.mod/v6/internal/runewidth"
+)
+
+const (
+	escChar = '\x1b'
+	belChar = '\a'
+
+	// oscIntro introduces an Operating System Command escape sequence
+	oscIntro = "\x1b]"
+	// strTerm is the String Terminator which ends an OSC escape sequence
+	strTerm = "\x1b\\"
+	// hyperlinkIntro introduces an OSC 8 (hyperlink) escape sequence
+	hyperlinkIntro = oscIntro + "8;"
+	// hyperlinkEnd is the OSC 8 escape sequence which ends a hyperlink
+	hyperlinkEnd = hyperlinkIntro + ";" + strTerm
+)
+
+// Hyperlink returns the text wrapped in the OSC 8 escape sequences which
+// will make it a clickable link to the URL when shown on a terminal which
+// supports it. Note that a Report will remove these escape sequences if it
+// is not writing to a terminal (see [Report.SetHyperlinks]).
+func Hyperlink(url, text string) string {
+	return hyperlinkIntro + ";" + url + strTerm +
+		text +
+		hyperlinkEnd
+}
+
+// opensHyperlink returns true if the escape sequence is an OSC 8 sequence
+// which starts a hyperlink. The sequence has the form "ESC ] 8 ; params ;
+// URI ST" and a sequence with an empty URI ends the hyperlink.
+func opensHyperlink(seq string) bool {
+	params, ok := strings.CutPrefix(seq, hyperlinkIntro)
+	if !ok {
+		return false
+	}
+
+	params = strings.TrimSuffix(params, strTerm)
+	params = strings.TrimSuffix(params, string(belChar))
+	_, uri, _ := strings.Cut(params, ";")
+
+	return uri != ""
+}
+
+// escSeqLen returns the length of the terminal escape sequence at the start
+// of the string or zero if there is none. Both OSC sequences (terminated
+// by either BEL or ESC-backslash) and CSI sequences (such as those used to
+// set colours) are recognised.
+func escSeqLen(s string) int {
+	if len(s) < 2 || s[0] != escChar { //nolint:mnd
+		return 0
+	}
+
+	switch s[1] {
+	case ']':
+		for i := 2; i < len(s); i++ {
+			if s[i] == belChar {
+				return i + 1
+			}
+
+			if strings.HasPrefix(s[i:], strTerm) {
+				return i + len(strTerm)
+			}
+		}
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+	}
+
+	return 0
+}
+
+// VisibleWidth returns the number of terminal columns taken by the string
+// when it is printed, so East Asian wide characters count as two columns.
+// Any terminal escape sequences (such as those generated by the Hyperlink
+// function) are not counted.
+func VisibleWidth(s string) int {
+	w := 0
+
+	for len(s) > 0 {
+		if n := escSeqLen(s); n > 0 {
+			s = s[n:]
+			continue
+		}
+
+		r, n := utf8.DecodeRuneInString(s)
+		s = s[n:]
+		w += runewidth.Rune(r)
+	}
+
+	return w
+}
+
+// stripHyperlinks returns the string with any OSC 8 (hyperlink) escape
+// sequences removed, leaving just the visible text.
+func stripHyperlinks(s string) string {
+	if !strings.Contains(s, hyperlinkIntro) {
+		return s
+	}
+
+	var b strings.Builder
+
+	for len(s) > 0 {
+		if strings.HasPrefix(s, hyperlinkIntro) {
+			if n := escSeqLen(s); n > 0 {
+				s = s[n:]
+				continue
+			}
+		}
+
+		b.WriteByte(s[0])
+		s = s[1:]
+	}
+
+	return b.String()
+}
+
+// isTerminal returns true if the writer is a terminal
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return fi.Mode()&os.ModeCharDevice != 0
+}
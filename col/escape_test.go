@@ -0,0 +1,103 @@
+package col_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestVisibleWidth(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		s      string
+		expVal int
+	}{
+		{
+			ID:     testhelper.MkID("plain"),
+			s:      "hello",
+			expVal: 5,
+		},
+		{
+			ID:     testhelper.MkID("multi-byte runes"),
+			s:      "héllo…",
+			expVal: 6,
+		},
+		{
+			ID:     testhelper.MkID("wide runes"),
+			s:      "日本語",
+			expVal: 6,
+		},
+		{
+			ID:     testhelper.MkID("combining mark"),
+			s:      "e\u0301",
+			expVal: 1,
+		},
+		{
+			ID:     testhelper.MkID("hyperlink"),
+			s:      col.Hyperlink("https://example.com", "hello"),
+			expVal: 5,
+		},
+		{
+			ID:     testhelper.MkID("OSC terminated by BEL"),
+			s:      "\x1b]8;;https://example.com\ahello\x1b]8;;\a",
+			expVal: 5,
+		},
+		{
+			ID:     testhelper.MkID("colour"),
+			s:      "\x1b[1;31mhello\x1b[0m",
+			expVal: 5,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "visible width",
+			col.VisibleWidth(tc.s), tc.expVal)
+	}
+}
+
+func TestHyperlinks(t *testing.T) {
+	const url = "https://example.com"
+
+	link := col.Hyperlink(url, "hi")
+
+	testCases := []struct {
+		testhelper.ID
+		showLinks   bool
+		expectedVal string
+	}{
+		{
+			ID:          testhelper.MkID("not a terminal"),
+			expectedVal: "hi    1\n",
+		},
+		{
+			ID:          testhelper.MkID("links forced on"),
+			showLinks:   true,
+			expectedVal: link + "    1\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		h := col.NewHeaderOrPanic(col.HdrOptDontPrint)
+
+		rpt := col.NewReportOrPanic(h, &b,
+			col.New(&colfmt.String{W: 4}, "link"),
+			col.New(&colfmt.Int{W: 2}, "int"))
+		if tc.showLinks {
+			rpt.SetHyperlinks(true)
+		}
+
+		err := rpt.PrintRow(link, 1)
+		if err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "report", b.String(),
+			tc.expectedVal)
+	}
+}
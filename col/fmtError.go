@@ -0,0 +1,91 @@
+package col
+
+import "fmt"
+
+// FormatError records the details of a value which could not be formatted
+type FormatError struct {
+	// Row is the number of the data row being printed (starting from 1)
+	Row int64
+	// ColIdx is the index of the column (starting from 0)
+	ColIdx int
+	// Headers holds the headers of the column
+	Headers []string
+	// Err is the error returned by the column's Formatter
+	Err error
+}
+
+// Error returns the string form of the FormatError
+func (err FormatError) Error() string {
+	return fmt.Sprintf("row %d: column[%d] (%q): %s",
+		err.Row, err.ColIdx, err.Headers, err.Err)
+}
+
+// Unwrap returns the error returned by the column's Formatter
+func (err FormatError) Unwrap() error {
+	return err.Err
+}
+
+// SetFmtErrMarker sets the marker to be shown in place of a value which a
+// column's Formatter could not format (see [FormatterE]). If the marker is
+// the empty string (the default) then the text returned by the Formatter,
+// which should describe the problem, is shown instead. In either case the
+// error is recorded; the recorded errors can be retrieved with
+// [Report.FmtErrs]. See [Report.SetFmtErrAbort] for how to stop the row
+// from being printed instead.
+func (rpt *Report) SetFmtErrMarker(marker string) *Report {
+	rpt.fmtErrMarker = marker
+	return rpt
+}
+
+// SetFmtErrAbort sets whether or not a row containing a value which a
+// column's Formatter could not format (see [FormatterE]) should be
+// printed. If abort is true then the row is not printed, the Report is left
+// as it was before the row was given and the [FormatError] is returned. By
+// default the row is printed (see [Report.SetFmtErrMarker]).
+func (rpt *Report) SetFmtErrAbort(abort bool) *Report {
+	rpt.fmtErrAbort = abort
+	return rpt
+}
+
+// FmtErrs returns the errors recorded when values could not be formatted
+// and the row was printed regardless (see [Report.SetFmtErrAbort])
+func (rpt Report) FmtErrs() []error {
+	return rpt.fmtErrs
+}
+
+// formatted returns the value formatted according to the column's
+// Formatter. If the value cannot be formatted then either the error is
+// returned or, if rows are not to be aborted, the error is recorded and
+// the marker (or, if there is none, the Formatter's text) is returned.
+func (rpt *Report) formatted(colIdx int, v any) (string, error) {
+	c := rpt.cols[colIdx]
+
+	fe, ok := c.f.(FormatterE)
+	if !ok {
+		return c.f.Formatted(v), nil
+	}
+
+	str, err := fe.FormattedE(v)
+	if err == nil {
+		return str, nil
+	}
+
+	err = FormatError{
+		Row:     rpt.hdr.dataRowsPrinted + 1,
+		ColIdx:  colIdx,
+		Headers: c.headers,
+		Err:     err,
+	}
+
+	if rpt.fmtErrAbort {
+		return "", err
+	}
+
+	rpt.fmtErrs = append(rpt.fmtErrs, err)
+
+	if rpt.fmtErrMarker == "" {
+		return str, nil
+	}
+
+	return rpt.fmtErrMarker, nil
+}
@@ -0,0 +1,117 @@
+package col_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFmtErr(t *testing.T) {
+	const badVal = `row 2: column[1] (["pct"]): colfmt.Percent:` +
+		` numeric value expected (got: string): x`
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		abort       bool
+		marker      string
+		expFmtErrs  int
+		expectedVal string
+	}{
+		{
+			ID:          testhelper.MkID("default"),
+			expFmtErrs:  1,
+			expectedVal: "a 50%\nb Numeric value expected (got: string): x\n",
+		},
+		{
+			ID:          testhelper.MkID("abort"),
+			ExpErr:      testhelper.MkExpErr(badVal),
+			abort:       true,
+			expectedVal: "a 50%\n",
+		},
+		{
+			ID:          testhelper.MkID("marker"),
+			marker:      "#ERR",
+			expFmtErrs:  1,
+			expectedVal: "a 50%\nb #ERR\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+			&b,
+			col.New(&colfmt.String{}, "str"),
+			col.New(&colfmt.Percent{}, "pct"))
+		rpt.SetFmtErrMarker(tc.marker).SetFmtErrAbort(tc.abort)
+
+		err := rpt.PrintRow("a", 0.5)
+		if err == nil {
+			err = rpt.PrintRow("b", "x")
+		}
+
+		testhelper.CheckExpErr(t, err, tc)
+
+		var fe col.FormatError
+		if err != nil && !errors.As(err, &fe) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error should be a FormatError")
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "recorded errors",
+			len(rpt.FmtErrs()), tc.expFmtErrs)
+		testhelper.DiffString(t, tc.IDStr(), "report",
+			b.String(), tc.expectedVal)
+	}
+}
+
+func TestFmtErrAbortLeavesReportUnchanged(t *testing.T) {
+	var b bytes.Buffer
+
+	rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(), &b,
+		col.New(&colfmt.String{
+			W:       1,
+			DupHdlr: colfmt.DupHdlr{SkipDups: true},
+		}, "D"),
+		col.New(&colfmt.String{W: 1}, "G").SkipGroupDups(nil),
+		col.New(&colfmt.Int{W: 1}, "N"))
+	rpt.SetFmtErrAbort(true)
+
+	rows := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		vals []any
+	}{
+		{
+			ID: testhelper.MkID("bad first row"),
+			ExpErr: testhelper.MkExpErr(`row 1: column[2] (["N"]):` +
+				` colfmt.Int: integer value expected (got: string): x`),
+			vals: []any{"a", "g", "x"},
+		},
+		{
+			ID:   testhelper.MkID("good row"),
+			vals: []any{"a", "g", 1},
+		},
+		{
+			ID:     testhelper.MkID("bad second row"),
+			ExpErr: testhelper.MkExpErr("row 2: column[2]"),
+			vals:   []any{"b", "h", "y"},
+		},
+		{
+			ID:   testhelper.MkID("good row, dups"),
+			vals: []any{"a", "g", 2},
+		},
+	}
+
+	for _, r := range rows {
+		testhelper.CheckExpErr(t, rpt.PrintRow(r.vals...), r)
+	}
+
+	testhelper.DiffString(t, "aborted rows", "report", b.String(),
+		"D G N\n= = =\na g 1\n    2\n")
+}
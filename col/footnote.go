@@ -0,0 +1,74 @@
+package col
+
+import (
+	"fmt"
+	"slices"
+)
+
+// noteMarkerFmt is the format used to show the marker of a footnote
+const noteMarkerFmt = "[%d]"
+
+// Noted wraps a value to be printed in a report together with the text of
+// a footnote. The value is formatted by the column's Formatter as usual
+// and the marker of the footnote is shown after it; note that the marker
+// adds to the width of the value. The footnotes are
+// numbered in the order in which they are first seen (identical notes
+// share a number) and are printed by [Report.Finish].
+type Noted struct {
+	Val  any
+	Note string
+}
+
+// AddHdrNote adds a footnote to the column. The marker of the footnote is
+// shown after the last line of the column's headers. Notes added to
+// columns are numbered before any notes attached to values.
+func (c *Col) AddHdrNote(note string) *Col {
+	c.hdrNotes = append(c.hdrNotes, note)
+	return c
+}
+
+// noteMarker returns the marker for the note, adding the note to the
+// report's notes if it has not been seen before
+func (rpt *Report) noteMarker(note string) string {
+	i := slices.Index(rpt.notes, note)
+	if i < 0 {
+		i = len(rpt.notes)
+		rpt.notes = append(rpt.notes, note)
+	}
+
+	return fmt.Sprintf(noteMarkerFmt, i+1)
+}
+
+// addHdrNotes records the markers of any column notes in the header so
+// that they can be shown after the last line of the column headers. The
+// Cols are not changed.
+func (rpt *Report) addHdrNotes() {
+	var markers []string
+
+	for i, c := range rpt.cols {
+		for _, note := range c.hdrNotes {
+			if markers == nil {
+				markers = make([]string, len(rpt.cols))
+			}
+
+			markers[i] += rpt.noteMarker(note)
+		}
+	}
+
+	rpt.hdr.noteMarkers = markers
+}
+
+// Finish prints the end of the report: any caption (see [HdrOptCaption])
+// followed by the numbered footnotes. It should be called once, after all
+// the rows have been printed.
+func (rpt *Report) Finish() error {
+	pwe := printWithErr{w: rpt.w}
+
+	rpt.hdr.printCaption(&pwe, rpt.cols)
+
+	for i, note := range rpt.notes {
+		pwe.println(fmt.Sprintf(noteMarkerFmt, i+1), note)
+	}
+
+	return pwe.error()
+}
@@ -0,0 +1,110 @@
+package col_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFootnotes(t *testing.T) {
+	var b bytes.Buffer
+
+	nameCol := col.New(&colfmt.String{W: 6}, "Name").AddHdrNote("the name")
+
+	rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(), &b,
+		nameCol, col.New(&colfmt.Int{W: 5}, "Value"))
+
+	rows := [][]any{
+		{"a", col.Noted{Val: 1, Note: "estimated"}},
+		{col.Noted{Val: "b", Note: "renamed"}, 2},
+		{"c", col.Noted{Val: 3, Note: "estimated"}},
+		{"d", col.Noted{Val: 4}},
+	}
+
+	for _, r := range rows {
+		if err := rpt.PrintRow(r...); err != nil {
+			t.Fatal("unexpected error printing a row: ", err)
+		}
+	}
+
+	err := rpt.PrintFooterVals(1, col.Noted{Val: 10, Note: "total"})
+	if err != nil {
+		t.Fatal("unexpected error printing the footer: ", err)
+	}
+
+	if err := rpt.Finish(); err != nil {
+		t.Fatal("unexpected error finishing the report: ", err)
+	}
+
+	testhelper.DiffString(t, "footnotes", "report", b.String(),
+		`Name[1] Value
+======= =====
+a        1[2]
+b[3]        2
+c        3[2]
+d           4
+        =====
+        10[4]
+[1] the name
+[2] estimated
+[3] renamed
+[4] total
+`)
+
+	// the Col is not changed so it can be used in another report
+	b.Reset()
+
+	rpt = col.NewReportOrPanic(col.NewHeaderOrPanic(), &b, nameCol)
+
+	if err := rpt.PrintRow("e"); err != nil {
+		t.Fatal("unexpected error printing a row: ", err)
+	}
+
+	if err := rpt.Finish(); err != nil {
+		t.Fatal("unexpected error finishing the report: ", err)
+	}
+
+	testhelper.DiffString(t, "footnotes, Col reused", "report", b.String(),
+		"Name[1]\n=======\ne      \n[1] the name\n")
+}
+
+func TestFootnotesDecAlign(t *testing.T) {
+	var b bytes.Buffer
+
+	rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+		&b, col.New(&colfmt.String{W: 8}).SetDecAlign(2, 2))
+
+	for _, v := range []string{"1.5", "12.25"} {
+		if err := rpt.PrintRow(col.Noted{Val: v, Note: "n"}); err != nil {
+			t.Fatal("unexpected error printing a row: ", err)
+		}
+	}
+
+	testhelper.DiffString(t, "footnotes, decimal-aligned", "report",
+		b.String(), " 1.5 [1]\n12.25[1]\n")
+}
+
+// errWriter is an io.Writer which always fails
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestFinishWriteErr(t *testing.T) {
+	rpt := col.NewReportOrPanic(
+		col.NewHeaderOrPanic(col.HdrOptCaption("Caption", col.TitleLeft)),
+		errWriter{}, col.New(&colfmt.Int{W: 1}))
+
+	testhelper.CheckExpErr(t, rpt.Finish(), struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("caption, failing writer"),
+		ExpErr: testhelper.MkExpErr("write failed"),
+	})
+}
@@ -19,3 +19,40 @@ type Formatter interface {
 	// Cols) before a Report is returned.
 	Check() error
 }
+
+// FormatterE is an optional interface which a Formatter can implement if it
+// is able to detect values which it cannot format. If a column's Formatter
+// implements this interface then the Report will call the FormattedE method
+// rather than the Formatted method and will handle any error it returns
+// (see [Report.SetFmtErrMarker] and [Report.SetFmtErrAbort]).
+type FormatterE interface {
+	Formatter
+	// FormattedE should return the value as a string. If the value cannot
+	// be formatted it should return a non-nil error; the string should
+	// then describe the problem so that it can be shown instead of the
+	// value if the error is ignored
+	FormattedE(any) (string, error)
+}
+
+// Resetter is an optional interface which a Formatter can implement if it
+// records state from one value to the next (for instance, the previous
+// value so that duplicates can be suppressed). The Reset method is called
+// at the start of each new section of a Report (see [Report.NewSection]).
+type Resetter interface {
+	// Reset should clear any state recorded from previous values
+	Reset()
+}
+
+// StateSaver is an optional interface which a Formatter can implement if it
+// records state from one value to the next. If a row is not printed
+// because one of its values could not be formatted (see
+// [Report.SetFmtErrAbort]) then the state of the Formatter is restored to
+// what it was before the row was formatted.
+type StateSaver interface {
+	// SaveState should return a copy of the state recorded from previous
+	// values
+	SaveState() any
+	// RestoreState should restore the state to a value returned by
+	// SaveState
+	RestoreState(any)
+}
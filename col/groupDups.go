@@ -0,0 +1,93 @@
+package col
+
+import "reflect"
+
+// EqualFunc is the signature of a function used to decide whether two
+// column values are equal
+type EqualFunc func(a, b any) bool
+
+// ValsEqual reports whether the two values are equal. Values of the same,
+// comparable, type are compared using the == operator, otherwise they are
+// compared using reflect.DeepEqual. Unlike a simple comparison using ==
+// this will not panic if the values are not comparable (if, for instance,
+// they are slices).
+func ValsEqual(a, b any) bool {
+	ta, tb := reflect.TypeOf(a), reflect.TypeOf(b)
+	if ta != tb {
+		return false
+	}
+
+	if ta == nil || ta.Comparable() {
+		return a == b
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// SkipGroupDups sets the column to be part of the grouping of the report
+// rows. A value in such a column is not shown if it is equal to the value
+// on the previous row and the values in all the grouped columns to its left
+// were also not shown. This gives a grouped listing where, for instance,
+// with the rows sorted by region and then by city, a city is shown again if
+// the region changes even if it has the same name as the previous city.
+//
+// The eq function is used to compare the values; if it is nil then
+// [ValsEqual] is used. The previous values are forgotten whenever the
+// header is printed.
+func (c *Col) SkipGroupDups(eq EqualFunc) *Col {
+	if eq == nil {
+		eq = ValsEqual
+	}
+
+	c.groupDupEq = eq
+
+	return c
+}
+
+// skipGroupDups replaces with Skip values any values in the grouped
+// columns which are duplicates of the values on the previous row, as
+// described in the [Col.SkipGroupDups] method. Any leading columns being
+// skipped are treated as if they held duplicate values.
+func (rpt *Report) skipGroupDups(skip int, vals []any) []any {
+	if rpt.prevVals == nil {
+		rpt.prevVals = make([]any, len(rpt.cols))
+		rpt.prevValSet = make([]bool, len(rpt.cols))
+	}
+
+	shown := make([]any, len(vals))
+	copy(shown, vals)
+
+	allDups := true
+
+	for i, v := range vals {
+		colIdx := i + skip
+		c := rpt.cols[colIdx]
+
+		if c.groupDupEq == nil {
+			continue
+		}
+
+		if _, ok := v.(Skip); ok {
+			continue
+		}
+
+		if allDups &&
+			rpt.prevValSet[colIdx] &&
+			c.groupDupEq(rpt.prevVals[colIdx], v) {
+			shown[i] = Skip{}
+		} else {
+			allDups = false
+		}
+
+		rpt.prevVals[colIdx] = v
+		rpt.prevValSet[colIdx] = true
+	}
+
+	return shown
+}
+
+// resetGroupDups forgets the previous values of the grouped columns
+func (rpt *Report) resetGroupDups() {
+	rpt.prevVals = nil
+	rpt.prevValSet = nil
+}
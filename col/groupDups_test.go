@@ -0,0 +1,96 @@
+package col_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestValsEqual(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		a, b   any
+		expVal bool
+	}{
+		{ID: testhelper.MkID("equal ints"), a: 1, b: 1, expVal: true},
+		{ID: testhelper.MkID("different types"), a: 1, b: int64(1)},
+		{ID: testhelper.MkID("both nil"), expVal: true},
+		{
+			ID: testhelper.MkID("equal slices"),
+			a:  []int{1, 2}, b: []int{1, 2},
+			expVal: true,
+		},
+		{ID: testhelper.MkID("different slices"), a: []int{1}, b: []int{2}},
+	}
+
+	for _, tc := range testCases {
+		if v := col.ValsEqual(tc.a, tc.b); v != tc.expVal {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: expected: %t, got: %t", tc.expVal, v)
+		}
+	}
+}
+
+func TestSkipGroupDups(t *testing.T) {
+	rows := [][]any{
+		{"North", "Leeds", 1},
+		{"North", "Leeds", 2},
+		{"North", "York", 3},
+		{"South", "York", 4},
+		{"South", "York", 5},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		hdrOpts     []col.HdrOptionFunc
+		expectedVal string
+	}{
+		{
+			ID:      testhelper.MkID("no header"),
+			hdrOpts: []col.HdrOptionFunc{col.HdrOptDontPrint},
+			expectedVal: `North Leeds 1
+            2
+      York  3
+South York  4
+            5
+`,
+		},
+		{
+			ID: testhelper.MkID("repeated header"),
+			hdrOpts: []col.HdrOptionFunc{
+				col.HdrOptDontUnderline,
+				col.HdrOptRepeat(3),
+			},
+			expectedVal: `Rgn   City  N
+North Leeds 1
+            2
+      York  3
+Rgn   City  N
+South York  4
+            5
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(tc.hdrOpts...), &b,
+			col.New(&colfmt.String{W: 5}, "Rgn").SkipGroupDups(nil),
+			col.New(&colfmt.String{W: 5}, "City").SkipGroupDups(nil),
+			col.New(&colfmt.Int{W: 1}, "N"))
+
+		for _, r := range rows {
+			if err := rpt.PrintRow(r...); err != nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: unexpected error: %s", err)
+			}
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "report",
+			b.String(), tc.expectedVal)
+	}
+}
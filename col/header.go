@@ -19,13 +19,35 @@ type Header struct {
 	underlineCh       string
 	headerRows        []string
 	dataRowsPrinted   int64
+	sectionStartRow   int64
 	repeatHdrInterval int64
 	headerRowCount    int
 	preHeaderFunc     PreHdrFunc
 	spanDups          bool
 	printHdr          bool
 	hdrPrinted        bool
+	hdrCreated        bool
 	underlineHdr      bool
+
+	title         string
+	titleAlign    TitleAlign
+	subtitle      string
+	subtitleAlign TitleAlign
+	caption       string
+	captionAlign  TitleAlign
+	titlesPrinted bool
+
+	noteMarkers []string
+}
+
+// noteMarker returns the footnote markers to be shown after the last line
+// of the headers of the column with the given index
+func (h Header) noteMarker(colIdx int) string {
+	if colIdx >= len(h.noteMarkers) {
+		return ""
+	}
+
+	return h.noteMarkers[colIdx]
 }
 
 // initVals sets the header row count and records, for each column, the
@@ -78,10 +100,10 @@ func (h *Header) addUnderlines(cols []*Col) {
 
 		sep := ""
 
-		for _, c := range cols {
+		for i, c := range cols {
 			underline.WriteString(sep)
 			sep = strings.Repeat(" ", len(c.sep))
-			s := c.headers[len(c.headers)-1]
+			s := c.headers[len(c.headers)-1] + h.noteMarker(i)
 			underline.WriteString(c.stringInCol(strings.Repeat(
 				h.underlineCh, len(s))))
 		}
@@ -105,7 +127,7 @@ func (h *Header) createHeader(cols []*Col) {
 		}
 	}
 
-	sg.setLastRowOfHeader()
+	sg.setLastRowOfHeader(h)
 
 	sg.setWidths()
 
@@ -148,22 +170,45 @@ func (h *Header) createHeaderFromSpans(sg spanGrid) {
 	}
 }
 
-// printHeader prints the header lines if necessary
-func (h *Header) printHeader(w io.Writer, cols []*Col) {
+// ensureCreated creates the header, if it is to be printed and has not
+// already been created, so that the final column widths are known
+func (h *Header) ensureCreated(cols []*Col) {
+	if h.printHdr && !h.hdrCreated {
+		h.createHeader(cols)
+		h.hdrCreated = true
+	}
+}
+
+// hdrDue returns true if the header should be printed before the next
+// row. It creates the header, if it has not already been created, so that
+// the final column widths are known.
+func (h *Header) hdrDue(cols []*Col) bool {
 	if !h.printHdr {
-		return
+		return false
 	}
 
-	if h.hdrPrinted {
-		if h.repeatHdrInterval == 0 {
-			return
-		}
+	h.ensureCreated(cols)
 
-		if h.dataRowsPrinted%h.repeatHdrInterval != 0 {
-			return
-		}
-	} else {
-		h.createHeader(cols)
+	if !h.hdrPrinted {
+		return true
+	}
+
+	return h.repeatHdrInterval != 0 &&
+		(h.dataRowsPrinted-h.sectionStartRow)%h.repeatHdrInterval == 0
+}
+
+// printHeader prints the header lines if necessary, preceded, the first
+// time, by any title and subtitle. It returns any error found while
+// printing.
+func (h *Header) printHeader(w io.Writer, cols []*Col) error {
+	pwe := printWithErr{w: w}
+
+	due := h.hdrDue(cols)
+
+	h.printTitles(&pwe, cols)
+
+	if !due {
+		return pwe.error()
 	}
 
 	if h.preHeaderFunc != nil {
@@ -171,10 +216,12 @@ func (h *Header) printHeader(w io.Writer, cols []*Col) {
 	}
 
 	for _, hr := range h.headerRows {
-		fmt.Fprintln(w, hr)
+		pwe.println(hr)
 	}
 
 	h.hdrPrinted = true
+
+	return pwe.error()
 }
 
 // HdrOptionFunc is the signature of the function that is passed to the
@@ -272,6 +319,14 @@ func NewHeaderOrPanic(options ...HdrOptionFunc) *Header {
 	return h
 }
 
+// startSection records the start of a new section of the report. The
+// header will be printed again before the next row and any repeats of the
+// header are counted from the start of the section.
+func (h *Header) startSection() {
+	h.hdrPrinted = false
+	h.sectionStartRow = h.dataRowsPrinted
+}
+
 // incrDataRowsPrinted increments the dataRowsPrinted (for defer)
 func (h *Header) incrDataRowsPrinted() {
 	h.dataRowsPrinted++
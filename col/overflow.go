@@ -0,0 +1,213 @@
+package col
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nickwells/col.mod/v6/internal/runewidth"
+)
+
+// Overflow describes what is done with a value which is too wide for its
+// column
+type Overflow int
+
+// The Overflow values:
+//
+//	OverflowExpand means the value is shown in full, pushing the rest of
+//	    the line to the right
+//	OverflowTruncate means the value is truncated to the width of the
+//	    column with a marker at the end showing that it has been truncated
+//	OverflowFill means the value is replaced by '#' characters filling the
+//	    column, as a spreadsheet does
+//	OverflowWrap means the value is split into column-width pieces which
+//	    are shown on successive lines
+const (
+	OverflowExpand Overflow = iota
+	OverflowTruncate
+	OverflowFill
+	OverflowWrap
+)
+
+// DfltOverflowMarker is the default marker used to show that a value has
+// been truncated
+const DfltOverflowMarker = "…"
+
+// overflowFillChar is the character used to fill a column when the
+// OverflowFill policy is used
+const overflowFillChar = "#"
+
+// OverflowEvent records the details of a value which was too wide for its
+// column
+type OverflowEvent struct {
+	// Row is the number of the data row being printed (starting from 1)
+	Row int64
+	// ColIdx is the index of the column (starting from 0)
+	ColIdx int
+	// Headers holds the headers of the column
+	Headers []string
+	// Width is the width of the column
+	Width int
+	// Val is the text which was too wide
+	Val string
+}
+
+// SetOverflow sets the way that values too wide for the column are shown
+// (the default is OverflowExpand). See [Overflow] for the available values.
+func (c *Col) SetOverflow(o Overflow) *Col {
+	c.overflow = o
+	return c
+}
+
+// SetOverflowMarker sets the marker used to show that a value has been
+// truncated when the Overflow policy is OverflowTruncate. If this is not
+// set the DfltOverflowMarker is used.
+func (c *Col) SetOverflowMarker(marker string) *Col {
+	c.overflowMarker = marker
+	return c
+}
+
+// SetOverflowFunc sets a function to be called whenever a value is too wide
+// for its column. This is called whatever the column's Overflow policy and
+// can be used, for instance, to detect Formatter widths which are too
+// small.
+func (rpt *Report) SetOverflowFunc(f func(OverflowEvent)) *Report {
+	rpt.overflowFunc = f
+	return rpt
+}
+
+// OverflowCount returns the number of times that a value has been too wide
+// for its column
+func (rpt Report) OverflowCount() int {
+	return rpt.overflowCount
+}
+
+// splitVisible splits the string so that the first part is no more than w
+// terminal columns wide. Any terminal escape sequences are kept but not
+// counted. At least one rune is always put in the first part so that
+// repeated splitting always makes progress. If the split falls inside a
+// hyperlink then the first part is ended with the escape sequence which
+// ends the hyperlink and the second part starts with the one which started
+// it so that each part is a complete hyperlink.
+func splitVisible(s string, w int) (string, string) {
+	i := 0
+	taken := false
+	openLink := ""
+
+	for visible := 0; i < len(s); {
+		if n := escSeqLen(s[i:]); n > 0 {
+			if seq := s[i : i+n]; strings.HasPrefix(seq, hyperlinkIntro) {
+				openLink = ""
+				if opensHyperlink(seq) {
+					openLink = seq
+				}
+			}
+
+			i += n
+
+			continue
+		}
+
+		r, n := utf8.DecodeRuneInString(s[i:])
+
+		rw := runewidth.Rune(r)
+		if visible+rw > w && taken {
+			break
+		}
+
+		i += n
+		visible += rw
+		taken = true
+	}
+
+	head, tail := s[:i], s[i:]
+	if openLink != "" && tail != "" {
+		head += hyperlinkEnd
+		tail = openLink + tail
+	}
+
+	return head, tail
+}
+
+// applyOverflow applies the column's Overflow policy to the line which is
+// too wide for the column and returns the resulting lines
+func (c Col) applyOverflow(line string) []string {
+	w := c.finalWidth
+	if w <= 0 {
+		return []string{line}
+	}
+
+	switch c.overflow {
+	case OverflowTruncate:
+		marker := c.overflowMarker
+		if marker == "" {
+			marker = DfltOverflowMarker
+		}
+
+		mw := VisibleWidth(marker)
+		if mw >= w {
+			head, _ := splitVisible(line, w)
+			return []string{head}
+		}
+
+		head, _ := splitVisible(line, w-mw)
+
+		return []string{head + marker}
+	case OverflowFill:
+		return []string{strings.Repeat(overflowFillChar, w)}
+	case OverflowWrap:
+		var lines []string
+
+		for VisibleWidth(line) > w {
+			var head string
+
+			head, line = splitVisible(line, w)
+			lines = append(lines, head)
+		}
+
+		return append(lines, line)
+	}
+
+	return []string{line}
+}
+
+// handleOverflow checks each of the lines for the column and applies the
+// Overflow policy to any which are too wide, recording the overflow
+func (rpt *Report) handleOverflow(colIdx int, lines []string) []string {
+	c := rpt.cols[colIdx]
+
+	var newLines []string
+
+	for i, line := range lines {
+		if VisibleWidth(line) <= c.finalWidth {
+			if newLines != nil {
+				newLines = append(newLines, line)
+			}
+
+			continue
+		}
+
+		rpt.overflowCount++
+
+		if rpt.overflowFunc != nil {
+			rpt.overflowFunc(OverflowEvent{
+				Row:     rpt.hdr.dataRowsPrinted + 1,
+				ColIdx:  colIdx,
+				Headers: c.headers,
+				Width:   c.finalWidth,
+				Val:     line,
+			})
+		}
+
+		if newLines == nil {
+			newLines = append([]string{}, lines[:i]...)
+		}
+
+		newLines = append(newLines, c.applyOverflow(line)...)
+	}
+
+	if newLines == nil {
+		return lines
+	}
+
+	return newLines
+}
@@ -0,0 +1,186 @@
+package col_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestOverflow(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		overflow    col.Overflow
+		marker      string
+		expectedVal string
+	}{
+		{
+			ID:          testhelper.MkID("expand"),
+			overflow:    col.OverflowExpand,
+			expectedVal: "abcdefghij 1\nabc   2\n",
+		},
+		{
+			ID:          testhelper.MkID("truncate"),
+			overflow:    col.OverflowTruncate,
+			expectedVal: "abcd… 1\nabc   2\n",
+		},
+		{
+			ID:          testhelper.MkID("truncate, own marker"),
+			overflow:    col.OverflowTruncate,
+			marker:      ">>",
+			expectedVal: "abc>> 1\nabc   2\n",
+		},
+		{
+			ID:          testhelper.MkID("fill"),
+			overflow:    col.OverflowFill,
+			expectedVal: "##### 1\nabc   2\n",
+		},
+		{
+			ID:          testhelper.MkID("wrap"),
+			overflow:    col.OverflowWrap,
+			expectedVal: "abcde 1\nfghij  \nabc   2\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var (
+			b      bytes.Buffer
+			events []col.OverflowEvent
+		)
+
+		c := col.New(&colfmt.String{W: 5}).SetOverflow(tc.overflow)
+		if tc.marker != "" {
+			c.SetOverflowMarker(tc.marker)
+		}
+
+		rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+			&b, c, col.New(&colfmt.Int{W: 1}))
+		rpt.SetOverflowFunc(func(e col.OverflowEvent) {
+			events = append(events, e)
+		})
+
+		for i, v := range []string{"abcdefghij", "abc"} {
+			if err := rpt.PrintRow(v, i+1); err != nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: unexpected error: %s", err)
+			}
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "report",
+			b.String(), tc.expectedVal)
+		testhelper.DiffInt(t, tc.IDStr(), "overflow count",
+			rpt.OverflowCount(), 1)
+
+		if len(events) != 1 {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: expected 1 overflow event, got: %d", len(events))
+
+			continue
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "overflow row",
+			int(events[0].Row), 1)
+		testhelper.DiffString(t, tc.IDStr(), "overflow value",
+			events[0].Val, "abcdefghij")
+	}
+}
+
+func TestOverflowWideRunes(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		overflow    col.Overflow
+		expectedVal string
+	}{
+		{
+			ID:          testhelper.MkID("truncate"),
+			overflow:    col.OverflowTruncate,
+			expectedVal: "日本… 1\n",
+		},
+		{
+			ID:          testhelper.MkID("wrap"),
+			overflow:    col.OverflowWrap,
+			expectedVal: "日本  1\n語テ   \nキ     \n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+			&b,
+			col.New(&colfmt.String{W: 5}).SetOverflow(tc.overflow),
+			col.New(&colfmt.Int{W: 1}))
+
+		if err := rpt.PrintRow("日本語テキ", 1); err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "report",
+			b.String(), tc.expectedVal)
+	}
+}
+
+func TestOverflowInFooter(t *testing.T) {
+	var b bytes.Buffer
+
+	rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+		&b, col.New(&colfmt.Int{W: 2}).SetOverflow(col.OverflowFill))
+
+	if err := rpt.PrintRow(12); err != nil {
+		t.Fatal("unexpected error printing a row: ", err)
+	}
+
+	if err := rpt.PrintFooterVals(0, 12345); err != nil {
+		t.Fatal("unexpected error printing the footer: ", err)
+	}
+
+	testhelper.DiffString(t, "footer overflow", "report",
+		b.String(), "12\n==\n##\n")
+	testhelper.DiffInt(t, "footer overflow", "overflow count",
+		rpt.OverflowCount(), 1)
+}
+
+func TestOverflowHyperlink(t *testing.T) {
+	const url = "https://example.com"
+
+	testCases := []struct {
+		testhelper.ID
+		overflow    col.Overflow
+		expectedVal string
+	}{
+		{
+			ID:          testhelper.MkID("truncate"),
+			overflow:    col.OverflowTruncate,
+			expectedVal: col.Hyperlink(url, "abcd") + "… 1\n",
+		},
+		{
+			ID:       testhelper.MkID("wrap"),
+			overflow: col.OverflowWrap,
+			expectedVal: col.Hyperlink(url, "abcde") + " 1\n" +
+				col.Hyperlink(url, "fghij") + "  \n" +
+				col.Hyperlink(url, "k") + "      \n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+			&b,
+			col.New(&colfmt.String{W: 5}).SetOverflow(tc.overflow),
+			col.New(&colfmt.Int{W: 1}))
+		rpt.SetHyperlinks(true)
+
+		err := rpt.PrintRow(col.Hyperlink(url, "abcdefghijk"), 1)
+		if err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "report",
+			b.String(), tc.expectedVal)
+	}
+}
@@ -10,14 +10,27 @@ import (
 
 // Report holds a collection of columns and header details
 type Report struct {
-	cols []*Col
-	hdr  *Header
-	w    io.Writer
+	cols       []*Col
+	hdr        *Header
+	w          io.Writer
+	hyperlinks bool
+	prevVals   []any
+	prevValSet []bool
+
+	overflowCount int
+	overflowFunc  func(OverflowEvent)
+
+	fmtErrMarker string
+	fmtErrAbort  bool
+	fmtErrs      []error
+
+	notes []string
 }
 
 // NewReport creates a new Report object. If the header is nil, it is
 // replaced with a newly constructed default header. If the writer is nil,
-// Stdout is used.
+// Stdout is used. Hyperlinks are only shown if the writer is a terminal
+// (see [Report.SetHyperlinks]).
 func NewReport(hdr *Header, w io.Writer, c *Col, cs ...*Col) (*Report, error) {
 	cols := []*Col{c}
 
@@ -37,11 +50,24 @@ func NewReport(hdr *Header, w io.Writer, c *Col, cs ...*Col) (*Report, error) {
 
 	hdr.initVals(cols)
 
-	return &Report{
-		cols: cols,
-		hdr:  hdr,
-		w:    w,
-	}, nil
+	rpt := &Report{
+		cols:       cols,
+		hdr:        hdr,
+		w:          w,
+		hyperlinks: isTerminal(w),
+	}
+	rpt.addHdrNotes()
+
+	return rpt, nil
+}
+
+// SetHyperlinks sets whether or not any hyperlinks (see [Hyperlink]) in
+// the formatted values should be shown. If not, just the text of the
+// hyperlink is shown. By default hyperlinks are only shown if the Report is
+// writing to a terminal.
+func (rpt *Report) SetHyperlinks(show bool) *Report {
+	rpt.hyperlinks = show
+	return rpt
 }
 
 // NewReportOrPanic returns a new Report object. If an error was returned
@@ -79,7 +105,7 @@ func checkColumns(cols []*Col) error {
 }
 
 // printFooter prints the footers under the numbered columns
-func (rpt Report) printFooter(skip int, vals ...any) error {
+func (rpt *Report) printFooter(skip int, vals ...any) error {
 	pwe := printWithErr{w: rpt.w}
 
 	sep := rpt.skipCols(&pwe, skip)
@@ -178,25 +204,59 @@ func (rpt *Report) PrintRowSkipCols(skip int, vals ...any) error {
 	return rpt.printRowSkipping(skip, vals...)
 }
 
-// printRowSkipping skips leading columns and prints the remainder. It prints
-// the header as necessary and increments the number of rows printed
+// printRowSkipping skips leading columns and prints the remainder. It
+// prints the header as necessary and increments the number of rows
+// printed. The values are all formatted before anything is printed; if any
+// of them cannot be formatted and the row is to be aborted (see
+// [Report.SetFmtErrAbort]) then nothing is printed and the Report is left
+// as it was.
 func (rpt *Report) printRowSkipping(skip int, vals ...any) error {
+	rs := rpt.saveRowState()
+
+	if rpt.hdr.hdrDue(rpt.cols) {
+		rpt.resetGroupDups()
+	}
+
+	lineVals, maxLines, err := rpt.splitVals(skip,
+		rpt.skipGroupDups(skip, vals)...)
+	if err != nil {
+		rpt.restoreRowState(rs)
+		return err
+	}
+
 	defer rpt.hdr.incrDataRowsPrinted()
 
-	rpt.hdr.printHeader(rpt.w, rpt.cols)
+	if err := rpt.hdr.printHeader(rpt.w, rpt.cols); err != nil {
+		return err
+	}
 
-	return rpt.printValsSkipping(skip, vals...)
+	return rpt.printLines(skip, lineVals, maxLines)
 }
 
 // printValsSkipping skips leading columns and prints the remainder. It does
-// not print the header or increment the number of rows printed
+// not print the header or increment the number of rows printed. If any of
+// the values cannot be formatted and the row is to be aborted then nothing
+// is printed and the Report is left as it was.
 func (rpt *Report) printValsSkipping(skip int, vals ...any) error {
-	pwe := printWithErr{w: rpt.w}
+	rs := rpt.saveRowState()
 
 	// generate all the lines to be printed for this row (note that some
 	// columns can be formatted into multiple lines of text)
-	lineVals, maxLines := rpt.splitVals(skip, vals...)
-	lineVals = addBlanks(lineVals, maxLines)
+	lineVals, maxLines, err := rpt.splitVals(skip, vals...)
+	if err != nil {
+		rpt.restoreRowState(rs)
+		return err
+	}
+
+	return rpt.printLines(skip, lineVals, maxLines)
+}
+
+// printLines prints the lines of formatted values, skipping leading columns
+func (rpt *Report) printLines(skip int, lineVals [][]string, maxLines int,
+) error {
+	pwe := printWithErr{w: rpt.w}
+
+	lineVals = rpt.addBlanks(skip, lineVals, maxLines)
 
 	for j := range maxLines {
 		sep := rpt.skipCols(&pwe, skip)
@@ -232,10 +292,38 @@ func (rpt *Report) skipCols(pwe *printWithErr, skip int) string {
 	return sep
 }
 
+// NewSection starts a new section of the report. Any Formatters which
+// implement the [Resetter] interface are reset, as are the values recorded
+// for suppressing duplicates (see [Col.SkipGroupDups]), and the header will
+// be printed again before the next row. If the title is not empty it is
+// printed immediately, after the report's title and subtitle if they have
+// not yet been printed (see [HdrOptTitle]).
+func (rpt *Report) NewSection(title string) error {
+	for _, c := range rpt.cols {
+		if r, ok := c.f.(Resetter); ok {
+			r.Reset()
+		}
+	}
+
+	rpt.resetGroupDups()
+	rpt.hdr.startSection()
+
+	pwe := printWithErr{w: rpt.w}
+
+	rpt.hdr.ensureCreated(rpt.cols)
+	rpt.hdr.printTitles(&pwe, rpt.cols)
+
+	if title != "" {
+		pwe.println(title)
+	}
+
+	return pwe.error()
+}
+
 // PrintFooterVals prints values for the footer. It does not print the header
 // or increment the number of rows printed. It will print Header.underlineCh
 // characters under the columns being printed
-func (rpt Report) PrintFooterVals(skip int, vals ...any) error {
+func (rpt *Report) PrintFooterVals(skip int, vals ...any) error {
 	if err := rpt.checkSkipVal(skip, len(vals)); err != nil {
 		return fmt.Errorf(
 			"PrintFooterVals(called from: %s):"+
@@ -288,41 +376,62 @@ func (rpt Report) checkSkipVal(skip, valCnt int) error {
 // newlines and adds each generated slice of values into the slice of slices
 // to be returned. It simultaneously keeps track of the maximum number of
 // lines detected. Finally it returns the collection of lines and the maximum
-// number of lines encountered.
-func (rpt *Report) splitVals(skip int, vals ...any) ([][]string, int) {
+// number of lines encountered. It returns a non-nil error if any of the
+// values cannot be formatted.
+func (rpt *Report) splitVals(skip int, vals ...any) ([][]string, int, error) {
 	var lineVals [][]string
 
 	maxLines := 0
 
 	for i, v := range vals {
-		c := rpt.cols[i+skip]
+		colIdx := i + skip
 		str := ""
+		marker := ""
 
 		if _, ok := v.(Skip); !ok {
-			str = c.f.Formatted(v)
+			var err error
+
+			note, noted := v.(Noted)
+			if noted {
+				v = note.Val
+			}
+
+			str, err = rpt.formatted(colIdx, v)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if noted && note.Note != "" {
+				marker = rpt.noteMarker(note.Note)
+			}
+		}
+
+		if !rpt.hyperlinks {
+			str = stripHyperlinks(str)
 		}
 
-		lines := strings.Split(str, "\n")
+		// the marker is added after the decimal alignment so that it is
+		// not taken to be part of the fraction
+		lines := rpt.cols[colIdx].decAlignLines(strings.Split(str, "\n"))
+		lines[len(lines)-1] += marker
+		lines = rpt.handleOverflow(colIdx, lines)
 
 		maxLines = max(len(lines), maxLines)
 
 		lineVals = append(lineVals, lines)
 	}
 
-	return lineVals, maxLines
+	return lineVals, maxLines, nil
 }
 
 // addBlanks takes a slice of slices (each of which may have different
 // numbers of members) and ensures that each has the same number of entries
-// by adding blank strings at the end.
-func addBlanks(lineVals [][]string, maxLines int) [][]string {
-	blanks := make([]string, maxLines)
-
+// by adding lines according to each column's vertical alignment and
+// continuation fill settings.
+func (rpt Report) addBlanks(skip int, lineVals [][]string, maxLines int,
+) [][]string {
 	for i, lines := range lineVals {
-		if len(lines) < maxLines {
-			lines = append(lines, blanks[:maxLines-len(lines)]...)
-			lineVals[i] = lines
-		}
+		lineVals[i] = rpt.cols[i+skip].fillLines(lines, maxLines)
 	}
 
 	return lineVals
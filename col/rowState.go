@@ -0,0 +1,61 @@
+package col
+
+import "slices"
+
+// rowState records the parts of the Report's state which are changed when
+// the values of a row are formatted so that the state can be restored if
+// the row is not printed
+type rowState struct {
+	prevVals      []any
+	prevValSet    []bool
+	overflowCount int
+	noteCount     int
+	fmtStates     map[int]any
+}
+
+// saveRowState returns a copy of the Report's state. A row is only
+// abandoned once its values have started to be formatted if rows with
+// values that cannot be formatted are to be aborted (see
+// [Report.SetFmtErrAbort]) so, otherwise, nothing is saved and nil is
+// returned.
+func (rpt *Report) saveRowState() *rowState {
+	if !rpt.fmtErrAbort {
+		return nil
+	}
+
+	rs := &rowState{
+		prevVals:      slices.Clone(rpt.prevVals),
+		prevValSet:    slices.Clone(rpt.prevValSet),
+		overflowCount: rpt.overflowCount,
+		noteCount:     len(rpt.notes),
+	}
+
+	for i, c := range rpt.cols {
+		if ss, ok := c.f.(StateSaver); ok {
+			if rs.fmtStates == nil {
+				rs.fmtStates = map[int]any{}
+			}
+
+			rs.fmtStates[i] = ss.SaveState()
+		}
+	}
+
+	return rs
+}
+
+// restoreRowState restores the Report's state to the saved value. Nothing
+// is done if the saved value is nil.
+func (rpt *Report) restoreRowState(rs *rowState) {
+	if rs == nil {
+		return
+	}
+
+	rpt.prevVals = rs.prevVals
+	rpt.prevValSet = rs.prevValSet
+	rpt.overflowCount = rs.overflowCount
+	rpt.notes = rpt.notes[:rs.noteCount]
+
+	for i, state := range rs.fmtStates {
+		rpt.cols[i].f.(StateSaver).RestoreState(state)
+	}
+}
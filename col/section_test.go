@@ -0,0 +1,70 @@
+package col_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestNewSection(t *testing.T) {
+	var b bytes.Buffer
+
+	rpt := col.NewReportOrPanic(
+		col.NewHeaderOrPanic(col.HdrOptDontUnderline, col.HdrOptRepeat(2)),
+		&b,
+		col.New(&colfmt.String{W: 4}, "Grp").SkipGroupDups(nil),
+		col.New(&colfmt.String{
+			W:       4,
+			DupHdlr: colfmt.DupHdlr{SkipDups: true},
+		}, "Dup"),
+		col.New(&colfmt.Int{W: 1}, "N"))
+
+	sections := []struct {
+		title string
+		rows  [][]any
+	}{
+		{
+			title: "Section 1",
+			rows: [][]any{
+				{"a", "x", 1},
+				{"a", "x", 2},
+				{"a", "y", 3},
+			},
+		},
+		{
+			title: "Section 2",
+			rows: [][]any{
+				{"a", "y", 4},
+				{"a", "y", 5},
+			},
+		},
+	}
+
+	for _, s := range sections {
+		if err := rpt.NewSection(s.title); err != nil {
+			t.Fatal("unexpected error starting a section: ", err)
+		}
+
+		for _, r := range s.rows {
+			if err := rpt.PrintRow(r...); err != nil {
+				t.Fatal("unexpected error printing a row: ", err)
+			}
+		}
+	}
+
+	testhelper.DiffString(t, "two sections", "report", b.String(),
+		`Section 1
+Grp  Dup  N
+a    x    1
+          2
+Grp  Dup  N
+a    y    3
+Section 2
+Grp  Dup  N
+a    y    4
+          5
+`)
+}
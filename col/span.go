@@ -137,16 +137,17 @@ func (sg spanGrid) setWidthsFromSpansAbove() {
 // setLastRowOfHeader sets the initial value of the last row of the header.
 // This is the (non-underline) row just above the data values in the printed
 // report and we do not span these headings so there is one entry per column.
+// Any footnote markers for the column are added to the header text.
 //
 //nolint:gosec
-func (sg spanGrid) setLastRowOfHeader() {
+func (sg spanGrid) setLastRowOfHeader(h *Header) {
 	row := len(sg.spans) - 1
 	for i, c := range sg.cols {
 		span := span{
 			start:    i,
 			end:      i,
 			row:      row,
-			hdrText:  c.hdrText(row, len(sg.spans)),
+			hdrText:  c.hdrText(row, len(sg.spans)) + h.noteMarker(i),
 			width:    c.finalWidth,
 			sepWidth: len(c.sep),
 		}
@@ -0,0 +1,141 @@
+package col
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TitleAlign describes how a title, subtitle or caption is placed over the
+// width of the report
+type TitleAlign int
+
+// The TitleAlign values:
+//
+//	TitleLeft means the text starts at the left edge of the report
+//	TitleCentre means the text is centred over the report
+//	TitleRight means the text ends at the right edge of the report
+const (
+	TitleLeft TitleAlign = iota
+	TitleCentre
+	TitleRight
+)
+
+// check returns a non-nil error if the TitleAlign value is invalid
+func (ta TitleAlign) check() error {
+	if ta < TitleLeft || ta > TitleRight {
+		return fmt.Errorf("bad TitleAlign: %d", ta)
+	}
+
+	return nil
+}
+
+// aligned returns the text aligned over the given width. Each line of the
+// text is aligned separately. Lines wider than the width are not changed.
+func (ta TitleAlign) aligned(text string, width int) string {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		padLen := width - VisibleWidth(line)
+		if padLen <= 0 {
+			continue
+		}
+
+		switch ta {
+		case TitleCentre:
+			lines[i] = strings.Repeat(" ", padLen/2) + line //nolint:mnd
+		case TitleRight:
+			lines[i] = strings.Repeat(" ", padLen) + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// titleOpt returns a HdrOptionFunc which checks the alignment and then
+// calls the setter
+func titleOpt(name string, ta TitleAlign, set func(*Header)) HdrOptionFunc {
+	return func(h *Header) error {
+		if err := ta.check(); err != nil {
+			return fmt.Errorf("the header %s: %w", name, err)
+		}
+
+		set(h)
+
+		return nil
+	}
+}
+
+// HdrOptTitle returns a HdrOptionFunc that will set the title of the
+// report. The title is printed, aligned over the width of the report, before
+// the header is first printed. It is printed even if the header is not.
+func HdrOptTitle(title string, ta TitleAlign) HdrOptionFunc {
+	return titleOpt("title", ta, func(h *Header) {
+		h.title = title
+		h.titleAlign = ta
+	})
+}
+
+// HdrOptSubtitle returns a HdrOptionFunc that will set the subtitle of the
+// report. The subtitle is printed, aligned over the width of the report,
+// after any title.
+func HdrOptSubtitle(subtitle string, ta TitleAlign) HdrOptionFunc {
+	return titleOpt("subtitle", ta, func(h *Header) {
+		h.subtitle = subtitle
+		h.subtitleAlign = ta
+	})
+}
+
+// HdrOptCaption returns a HdrOptionFunc that will set the caption of the
+// report. The caption is printed, aligned over the width of the report, by
+// [Report.Finish].
+func HdrOptCaption(caption string, ta TitleAlign) HdrOptionFunc {
+	return titleOpt("caption", ta, func(h *Header) {
+		h.caption = caption
+		h.captionAlign = ta
+	})
+}
+
+// totalWidth returns the width of the report; this is the sum of the
+// widths of the columns and the separators between them (the separator
+// of a column is printed after it). Note that the column widths are only
+// final once the header has been created.
+func totalWidth(cols []*Col) int {
+	w := 0
+
+	for i, c := range cols {
+		if i > 0 {
+			w += VisibleWidth(cols[i-1].sep)
+		}
+
+		w += c.finalWidth
+	}
+
+	return w
+}
+
+// printTitles prints the title and subtitle if they have not already been
+// printed
+func (h *Header) printTitles(pwe *printWithErr, cols []*Col) {
+	if h.titlesPrinted {
+		return
+	}
+
+	h.titlesPrinted = true
+
+	width := totalWidth(cols)
+
+	if h.title != "" {
+		pwe.println(h.titleAlign.aligned(h.title, width))
+	}
+
+	if h.subtitle != "" {
+		pwe.println(h.subtitleAlign.aligned(h.subtitle, width))
+	}
+}
+
+// printCaption prints the caption, if there is one
+func (h *Header) printCaption(pwe *printWithErr, cols []*Col) {
+	if h.caption != "" {
+		pwe.println(h.captionAlign.aligned(h.caption, totalWidth(cols)))
+	}
+}
@@ -0,0 +1,132 @@
+package col_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTitles(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		opts        []col.HdrOptionFunc
+		expectedVal string
+	}{
+		{
+			ID: testhelper.MkID("left title, centred subtitle"),
+			opts: []col.HdrOptionFunc{
+				col.HdrOptTitle("Title", col.TitleLeft),
+				col.HdrOptSubtitle("Sub", col.TitleCentre),
+			},
+			expectedVal: "Title\n" +
+				"    Sub\n" +
+				"Name   Value\n" +
+				"====   =====\n" +
+				"a         42\n",
+		},
+		{
+			ID: testhelper.MkID("right title, multi-line"),
+			opts: []col.HdrOptionFunc{
+				col.HdrOptTitle("Title\nT", col.TitleRight),
+			},
+			expectedVal: "       Title\n" +
+				"           T\n" +
+				"Name   Value\n" +
+				"====   =====\n" +
+				"a         42\n",
+		},
+		{
+			ID: testhelper.MkID("title too wide"),
+			opts: []col.HdrOptionFunc{
+				col.HdrOptTitle("A very long title", col.TitleCentre),
+			},
+			expectedVal: "A very long title\n" +
+				"Name   Value\n" +
+				"====   =====\n" +
+				"a         42\n",
+		},
+		{
+			ID: testhelper.MkID("no header, with title"),
+			opts: []col.HdrOptionFunc{
+				col.HdrOptDontPrint,
+				col.HdrOptTitle("T", col.TitleCentre),
+			},
+			expectedVal: "    T\n" +
+				"a      42\n",
+		},
+		{
+			ID: testhelper.MkID("caption"),
+			opts: []col.HdrOptionFunc{
+				col.HdrOptCaption("Caption", col.TitleRight),
+			},
+			expectedVal: "Name   Value\n" +
+				"====   =====\n" +
+				"a         42\n" +
+				"     Caption\n",
+		},
+		{
+			ID:     testhelper.MkID("bad alignment"),
+			ExpErr: testhelper.MkExpErr("the header title: bad TitleAlign: 3"),
+			opts: []col.HdrOptionFunc{
+				col.HdrOptTitle("Title", col.TitleRight+1),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		h, err := col.NewHeader(tc.opts...)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			rpt := col.NewReportOrPanic(h, &b,
+				col.New(&colfmt.String{W: 4}, "Name").SetSep("   "),
+				col.New(&colfmt.Int{W: 2}, "Value"))
+
+			if err := rpt.PrintRow("a", 42); err != nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: unexpected error: %s", err)
+			}
+
+			if err := rpt.Finish(); err != nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: unexpected error: %s", err)
+			}
+
+			testhelper.DiffString(t, tc.IDStr(), "report",
+				b.String(), tc.expectedVal)
+		}
+	}
+}
+
+func TestTitleWithSections(t *testing.T) {
+	var b bytes.Buffer
+
+	rpt := col.NewReportOrPanic(
+		col.NewHeaderOrPanic(col.HdrOptTitle("My Report", col.TitleCentre)),
+		&b, col.New(&colfmt.String{W: 11}, "name"))
+
+	for _, section := range []string{"Section A", "Section B"} {
+		if err := rpt.NewSection(section); err != nil {
+			t.Fatal("unexpected error starting a section: ", err)
+		}
+
+		if err := rpt.PrintRow("x"); err != nil {
+			t.Fatal("unexpected error printing a row: ", err)
+		}
+	}
+
+	testhelper.DiffString(t, "title with sections", "report", b.String(),
+		" My Report\n"+
+			"Section A\n"+
+			"name       \n"+
+			"====       \n"+
+			"x          \n"+
+			"Section B\n"+
+			"name       \n"+
+			"====       \n"+
+			"x          \n")
+}
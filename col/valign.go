@@ -0,0 +1,109 @@
+package col
+
+// VAlign describes where the lines of a cell are placed when another cell
+// in the same row has more lines
+type VAlign int
+
+// The VAlign values:
+//
+//	VAlignTop means the lines are placed at the top of the row
+//	VAlignMiddle means the lines are placed in the middle of the row
+//	VAlignBottom means the lines are placed at the bottom of the row
+const (
+	VAlignTop VAlign = iota
+	VAlignMiddle
+	VAlignBottom
+)
+
+// ContFill describes how the spare lines of a cell are filled when another
+// cell in the same row has more lines
+type ContFill int
+
+// The ContFill values:
+//
+//	ContBlank means the spare lines are left blank
+//	ContRepeat means a single-line value is repeated on every line of the
+//	    row. Values with more than one line have their spare lines left
+//	    blank
+//	ContMarker means the spare lines are filled with the marker
+const (
+	ContBlank ContFill = iota
+	ContRepeat
+	ContMarker
+)
+
+// SetVAlign sets the vertical alignment of the column's cells (the default
+// is VAlignTop). This only has an effect if the formatted value in some
+// column has more lines than the value in this column.
+func (c *Col) SetVAlign(va VAlign) *Col {
+	c.vAlign = va
+	return c
+}
+
+// SetContFill sets the way that the spare lines of the column's cells are
+// filled (the default is ContBlank). The marker is only used if the
+// ContFill is ContMarker. This only has an effect if the formatted value in
+// some column has more lines than the value in this column.
+func (c *Col) SetContFill(cf ContFill, marker string) *Col {
+	c.contFill = cf
+	c.contMarker = marker
+
+	return c
+}
+
+// fillLines returns the lines of the cell placed according to the
+// column's vertical alignment, with the spare lines filled according to
+// the ContFill setting, so that there are maxLines lines.
+func (c Col) fillLines(lines []string, maxLines int) []string {
+	spare := maxLines - len(lines)
+	if spare <= 0 {
+		return lines
+	}
+
+	fill := ""
+
+	switch c.contFill {
+	case ContRepeat:
+		if len(lines) == 1 {
+			fill = lines[0]
+		}
+	case ContMarker:
+		if !allBlank(lines) {
+			fill = c.contMarker
+		}
+	}
+
+	above := 0
+
+	switch c.vAlign {
+	case VAlignMiddle:
+		above = spare / 2 //nolint:mnd
+	case VAlignBottom:
+		above = spare
+	}
+
+	filled := make([]string, 0, maxLines)
+
+	for range above {
+		filled = append(filled, fill)
+	}
+
+	filled = append(filled, lines...)
+
+	for range spare - above {
+		filled = append(filled, fill)
+	}
+
+	return filled
+}
+
+// allBlank returns true if all the lines are empty
+func allBlank(lines []string) bool {
+	for _, l := range lines {
+		if l != "" {
+			return false
+		}
+	}
+
+	return true
+}
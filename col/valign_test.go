@@ -0,0 +1,92 @@
+package col_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestVAlign(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		vAlign      col.VAlign
+		contFill    col.ContFill
+		marker      string
+		val         string
+		expectedVal string
+	}{
+		{
+			ID:          testhelper.MkID("top"),
+			vAlign:      col.VAlignTop,
+			val:         "x",
+			expectedVal: "x a\n  b\n  c\n",
+		},
+		{
+			ID:          testhelper.MkID("middle"),
+			vAlign:      col.VAlignMiddle,
+			val:         "x",
+			expectedVal: "  a\nx b\n  c\n",
+		},
+		{
+			ID:          testhelper.MkID("bottom"),
+			vAlign:      col.VAlignBottom,
+			val:         "x",
+			expectedVal: "  a\n  b\nx c\n",
+		},
+		{
+			ID:          testhelper.MkID("middle, two lines"),
+			vAlign:      col.VAlignMiddle,
+			val:         "x\ny",
+			expectedVal: "x a\ny b\n  c\n",
+		},
+		{
+			ID:          testhelper.MkID("repeat"),
+			contFill:    col.ContRepeat,
+			val:         "x",
+			expectedVal: "x a\nx b\nx c\n",
+		},
+		{
+			ID:          testhelper.MkID("repeat, two lines"),
+			contFill:    col.ContRepeat,
+			val:         "x\ny",
+			expectedVal: "x a\ny b\n  c\n",
+		},
+		{
+			ID:          testhelper.MkID("marker, bottom"),
+			vAlign:      col.VAlignBottom,
+			contFill:    col.ContMarker,
+			marker:      "|",
+			val:         "x",
+			expectedVal: "| a\n| b\nx c\n",
+		},
+		{
+			ID:          testhelper.MkID("marker, blank value"),
+			contFill:    col.ContMarker,
+			marker:      "|",
+			val:         "",
+			expectedVal: "  a\n  b\n  c\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var b bytes.Buffer
+
+		c := col.New(&colfmt.String{W: 1}).
+			SetVAlign(tc.vAlign).
+			SetContFill(tc.contFill, tc.marker)
+
+		rpt := col.NewReportOrPanic(col.NewHeaderOrPanic(col.HdrOptDontPrint),
+			&b, c, col.New(&colfmt.String{W: 1}))
+
+		if err := rpt.PrintRow(tc.val, "a\nb\nc"); err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "report",
+			b.String(), tc.expectedVal)
+	}
+}
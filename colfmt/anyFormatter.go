@@ -0,0 +1,128 @@
+package colfmt
+
+import (
+	"encoding"
+	"fmt"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// anyAsString returns the value converted into a string. If the value is an
+// error, a fmt.Stringer or an encoding.TextMarshaler then the corresponding
+// method is used, otherwise the value is formatted with the given fmt verb.
+// Typed nil values are always formatted with the fmt verb as the method
+// may dereference its nil receiver; fmt recovers from any such panic.
+func anyAsString(v any, verb rune) string {
+	if isNil(v) {
+		return fmt.Sprintf("%"+string(verb), v)
+	}
+
+	switch val := v.(type) {
+	case string:
+		if verb == 'v' || verb == 's' {
+			return val
+		}
+	case error:
+		return val.Error()
+	case fmt.Stringer:
+		return val.String()
+	case encoding.TextMarshaler:
+		if text, err := val.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+
+	return fmt.Sprintf("%"+string(verb), v)
+}
+
+// Any records the values needed for the formatting of a value of any
+// type. If the value is an error, a fmt.Stringer or an
+// encoding.TextMarshaler then the corresponding method is used to generate
+// the text to be shown, otherwise the value is formatted using the Verb.
+//
+// See [NilHdlr] and [DupHdlr] for the settings that can be given through
+// those types.
+type Any struct {
+	// W gives the minimum width of the value that should be printed
+	W int
+	// MaxW gives the maximum width of the value, if it is set to zero then
+	// no limit is applied. If it is set to a negative value then the W value
+	// is used. If it is a positive value then that is used
+	MaxW int
+	// StrJust gives the justification to be used
+	StrJust col.Justification
+	// Verb specifies the formatting verb to be used for values which cannot
+	// convert themselves to text. If left unset it will use 'v'. There will
+	// be a panic if it is not one of 'vsqxXT'
+	Verb rune
+	// DupIndicator is the value to show if the value to be shown is the same
+	// as the value shown on the previous line. Setting this value without
+	// also setting the DupHdlr.SkipDups flag will have no effect. Note that
+	// if the DupIndicator is too long to fit in the column it will be
+	// truncated according to the settings of the W and MaxW values.
+	DupIndicator string
+
+	NilHdlr
+	DupHdlr
+}
+
+// verb returns the formatting verb to use, checking that it is valid
+func (f Any) verb() rune {
+	switch f.Verb {
+	case 0:
+		return 'v'
+	case 'v', 's', 'q', 'x', 'X', 'T':
+		return f.Verb
+	default:
+		panic(fmt.Errorf("%T: bad Format verb: %q", f, f.Verb))
+	}
+}
+
+// maxWidth returns the maximum width of the formatted value or zero if
+// there is no limit
+func (f Any) maxWidth() int {
+	if f.MaxW < 0 {
+		return max(f.W, 0)
+	}
+
+	return f.MaxW
+}
+
+// Formatted returns the value formatted as a string
+func (f *Any) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	s := f.DupIndicator
+	if !f.SkipDup(v) {
+		s = anyAsString(v, f.verb())
+	}
+
+	if maxW := f.maxWidth(); maxW > 0 {
+		return truncWidth(s, maxW)
+	}
+
+	return s
+}
+
+// Width returns the intended width of the value
+func (f Any) Width() int {
+	return f.W
+}
+
+// Just returns the justification of the value
+func (f Any) Just() col.Justification {
+	return f.StrJust
+}
+
+// Check returns a non-nil error if the Verb is invalid
+func (f Any) Check() error {
+	switch f.Verb {
+	case 0, 'v', 's', 'q', 'x', 'X', 'T':
+	default:
+		return fmt.Errorf("%T: bad Format verb: %q", f, f.Verb)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package colfmt_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// derefErr is an error whose Error method dereferences its receiver
+type derefErr struct {
+	msg string
+}
+
+func (e *derefErr) Error() string { return e.msg }
+
+func TestAnyFormatter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		af     colfmt.Any
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("string"),
+			val:    "hello",
+			expStr: "hello",
+		},
+		{
+			ID:     testhelper.MkID("error"),
+			val:    errors.New("bad thing"),
+			expStr: "bad thing",
+		},
+		{
+			ID:     testhelper.MkID("Stringer"),
+			val:    90 * time.Second,
+			expStr: "1m30s",
+		},
+		{
+			ID:     testhelper.MkID("TextMarshaler"),
+			val:    net.IPv4(127, 0, 0, 1).To4(),
+			expStr: "127.0.0.1",
+		},
+		{
+			ID:     testhelper.MkID("other, default verb"),
+			val:    []int{1, 2},
+			expStr: "[1 2]",
+		},
+		{
+			ID:     testhelper.MkID("other, quoted"),
+			af:     colfmt.Any{Verb: 'q'},
+			val:    "hello",
+			expStr: `"hello"`,
+		},
+		{
+			ID:     testhelper.MkID("max width"),
+			af:     colfmt.Any{MaxW: 3},
+			val:    "hello",
+			expStr: "hel",
+		},
+		{
+			ID:     testhelper.MkID("max width from W"),
+			af:     colfmt.Any{W: 4, MaxW: -1},
+			val:    "hello",
+			expStr: "hell",
+		},
+		{
+			ID:     testhelper.MkID("typed nil error"),
+			val:    (*derefErr)(nil),
+			expStr: "<nil>",
+		},
+		{
+			ID:     testhelper.MkID("ignore nil, pass nil"),
+			af:     colfmt.Any{NilHdlr: colfmt.NilHdlr{IgnoreNil: true}},
+			expStr: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.af.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
@@ -0,0 +1,155 @@
+package colfmt
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+const (
+	// barFullBlock is the character used for a completely filled cell
+	barFullBlock = "█"
+	// barASCIIBlock is the character used for a filled cell when the bar
+	// is being drawn with ASCII characters only
+	barASCIIBlock = "#"
+	// barEighths is the number of parts that a cell can be divided into
+	// when drawing the bar with Unicode block characters
+	barEighths = 8
+)
+
+// barPartBlocks holds the characters used to show a partially filled
+// cell. The index is the number of eighths of the cell to be filled.
+var barPartBlocks = []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// Bar records the values needed for the formatting of a numeric value as a
+// horizontal bar. The length of the bar is proportional to the value as a
+// fraction of the maximum value so that a value equal to the maximum will
+// fill the column. Unless the ASCII flag is set the bar is drawn with the
+// Unicode block characters which allows the bar length to be shown to an
+// eighth of a character.
+//
+// Negative values are shown as an empty bar and values greater than the
+// maximum (including +Inf) are shown as a full bar.
+//
+// See [NilHdlr] for the settings that can be given through that type.
+type Bar struct {
+	// W gives the width of the bar when the value is equal to the maximum
+	// value. If it is less than 1 then a width of 1 is used
+	W int
+	// Max gives the value which will fill the column. If it is not set then
+	// the largest value seen so far is used. Note that this means that bars
+	// on earlier lines may be drawn to a different scale from those on
+	// later lines so you should set this value if you can
+	Max float64
+	// ASCII, if set to true, will draw the bar using '#' characters rather
+	// than the Unicode block characters
+	ASCII bool
+
+	maxSeen float64
+
+	NilHdlr
+}
+
+// maxVal returns the value corresponding to a full bar. Infinite values are
+// not recorded as the largest value seen as they would leave every other
+// bar empty.
+func (f *Bar) maxVal(f64 float64) float64 {
+	if f.Max > 0 {
+		return f.Max
+	}
+
+	if !math.IsInf(f64, 0) {
+		f.maxSeen = max(f.maxSeen, f64)
+	}
+
+	return f.maxSeen
+}
+
+// Formatted returns the value formatted as a bar
+func (f *Bar) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	f64, ok := getNumAsFloat64(v)
+	if !ok {
+		return fmt.Sprintf("Numeric value expected (got: %T): %v", v, v)
+	}
+
+	if math.IsNaN(f64) {
+		return ""
+	}
+
+	maxVal := f.maxVal(f64)
+
+	if f64 <= 0 {
+		return ""
+	}
+
+	prop := 1.0
+	if !math.IsInf(f64, 1) {
+		if maxVal <= 0 {
+			return ""
+		}
+
+		prop = min(f64/maxVal, 1.0)
+	}
+
+	if math.IsNaN(prop) {
+		return ""
+	}
+
+	if f.ASCII {
+		return strings.Repeat(barASCIIBlock,
+			int(math.Round(prop*float64(f.Width()))))
+	}
+
+	eighths := int(math.Round(prop * float64(f.Width()*barEighths)))
+
+	return strings.Repeat(barFullBlock, eighths/barEighths) +
+		barPartBlocks[eighths%barEighths]
+}
+
+// Width returns the intended width of the value
+func (f Bar) Width() int {
+	if f.W <= 0 {
+		return 1
+	}
+
+	return f.W
+}
+
+// Just returns the justification of the value
+func (f Bar) Just() col.Justification {
+	return col.Left
+}
+
+// Check returns a non-nil error if the Max value is invalid
+func (f Bar) Check() error {
+	if f.Max < 0 || math.IsNaN(f.Max) || math.IsInf(f.Max, 0) {
+		return fmt.Errorf("%T: bad Max value: %g", f, f.Max)
+	}
+
+	return nil
+}
+
+// Reset forgets the largest value seen so far
+func (f *Bar) Reset() {
+	f.maxSeen = 0
+}
+
+// SaveState returns the largest value seen so far so that it can be
+// restored
+func (f *Bar) SaveState() any {
+	return f.maxSeen
+}
+
+// RestoreState restores the largest value seen from a value returned by
+// SaveState
+func (f *Bar) RestoreState(state any) {
+	if maxSeen, ok := state.(float64); ok {
+		f.maxSeen = maxSeen
+	}
+}
@@ -0,0 +1,112 @@
+package colfmt_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestBarFormatter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		bf     colfmt.Bar
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("basic, max value"),
+			bf:     colfmt.Bar{W: 4, Max: 10},
+			val:    10,
+			expStr: "████",
+		},
+		{
+			ID:     testhelper.MkID("basic, partial value"),
+			bf:     colfmt.Bar{W: 4, Max: 8},
+			val:    5.25,
+			expStr: "██▋",
+		},
+		{
+			ID:     testhelper.MkID("basic, too big"),
+			bf:     colfmt.Bar{W: 2, Max: 1},
+			val:    2.5,
+			expStr: "██",
+		},
+		{
+			ID:     testhelper.MkID("basic, negative"),
+			bf:     colfmt.Bar{W: 2, Max: 1},
+			val:    -1,
+			expStr: "",
+		},
+		{
+			ID:     testhelper.MkID("basic, NaN"),
+			bf:     colfmt.Bar{W: 2, Max: 1},
+			val:    math.NaN(),
+			expStr: "",
+		},
+		{
+			ID:     testhelper.MkID("basic, +Inf"),
+			bf:     colfmt.Bar{W: 2, Max: 1},
+			val:    math.Inf(1),
+			expStr: "██",
+		},
+		{
+			ID:     testhelper.MkID("basic, -Inf"),
+			bf:     colfmt.Bar{W: 2, Max: 1},
+			val:    math.Inf(-1),
+			expStr: "",
+		},
+		{
+			ID:     testhelper.MkID("auto max, +Inf"),
+			bf:     colfmt.Bar{W: 2},
+			val:    math.Inf(1),
+			expStr: "██",
+		},
+		{
+			ID:     testhelper.MkID("auto max, -Inf"),
+			bf:     colfmt.Bar{W: 2},
+			val:    math.Inf(-1),
+			expStr: "",
+		},
+		{
+			ID:     testhelper.MkID("ASCII"),
+			bf:     colfmt.Bar{W: 4, Max: 8, ASCII: true},
+			val:    5.25,
+			expStr: "###",
+		},
+		{
+			ID:     testhelper.MkID("auto max"),
+			bf:     colfmt.Bar{W: 3},
+			val:    uint8(7),
+			expStr: "███",
+		},
+		{
+			ID:     testhelper.MkID("not a number"),
+			bf:     colfmt.Bar{W: 3},
+			val:    "nonesuch",
+			expStr: "Numeric value expected (got: string): nonesuch",
+		},
+		{
+			ID:     testhelper.MkID("ignore nil, pass nil"),
+			bf:     colfmt.Bar{NilHdlr: colfmt.NilHdlr{IgnoreNil: true}},
+			expStr: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.bf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
+
+func TestBarAutoMaxIgnoresInf(t *testing.T) {
+	bf := colfmt.Bar{W: 4}
+
+	for _, v := range []float64{math.Inf(1), 8, math.Inf(1), 4} {
+		bf.Formatted(v)
+	}
+
+	testhelper.DiffString(t, "auto max after +Inf", "formatted value",
+		bf.Formatted(2), "█")
+}
@@ -2,11 +2,26 @@ package colfmt
 
 import (
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/nickwells/col.mod/v6/col"
 )
 
-// Bool records the values needed for the formatting of a bool value.
+// These are the default labels used by the Bool Formatter
+const (
+	DfltTrueLabel  = "true"
+	DfltFalseLabel = "false"
+	DfltFlagMarker = "✓"
+)
+
+// Bool records the values needed for the formatting of a bool value. As
+// well as bool values it will accept a *bool and a string representation
+// of a bool such as "1", "t", "true", "y", "yes" or "on" (and their false
+// equivalents); the case of the string is ignored. A nil *bool is shown
+// using the NilLabel which allows tri-state values to be shown.
 //
 // See [NilHdlr] and [DupHdlr] for the settings that can be given through
 // those types.
@@ -15,27 +30,119 @@ type Bool struct {
 	W int
 	// StrJust gives the justification to be used
 	StrJust col.Justification
+	// TrueLabel gives the value to be shown for true values. If it is not
+	// set then "true" is shown or, if Flag is set, "✓"
+	TrueLabel string
+	// FalseLabel gives the value to be shown for false values. If it is not
+	// set then "false" is shown. It is not used if Flag is set
+	FalseLabel string
+	// NilLabel gives the value to be shown for nil values
+	NilLabel string
+	// Flag, if set to true, will show only the TrueLabel for true values;
+	// false values are left blank
+	Flag bool
 
 	NilHdlr
 	DupHdlr
 }
 
+// getBool converts the value into a bool if possible; values of named bool
+// types are converted to their underlying bool value. The second result
+// will be true if the value is a nil *bool and the final result will be
+// false if the value cannot be converted.
+func getBool(v any) (bool, bool, bool) {
+	switch b := v.(type) {
+	case bool:
+		return b, false, true
+	case *bool:
+		if b == nil {
+			return false, true, true
+		}
+
+		return *b, false, true
+	case nil:
+		return false, true, true
+	case string:
+		switch strings.ToLower(b) {
+		case "y", "yes", "on":
+			return true, false, true
+		case "n", "no", "off":
+			return false, false, true
+		}
+
+		if val, err := strconv.ParseBool(strings.ToLower(b)); err == nil {
+			return val, false, true
+		}
+	default:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Bool {
+			return rv.Bool(), false, true
+		}
+	}
+
+	return false, false, false
+}
+
+// trueLabel returns the value to be shown for a true value
+func (f Bool) trueLabel() string {
+	switch {
+	case f.TrueLabel != "":
+		return f.TrueLabel
+	case f.Flag:
+		return DfltFlagMarker
+	}
+
+	return DfltTrueLabel
+}
+
+// falseLabel returns the value to be shown for a false value
+func (f Bool) falseLabel() string {
+	switch {
+	case f.Flag:
+		return ""
+	case f.FalseLabel != "":
+		return f.FalseLabel
+	}
+
+	return DfltFalseLabel
+}
+
 // Formatted returns the value formatted as a bool
 func (f *Bool) Formatted(v any) string {
 	if f.SkipNil(v) {
-		return ""
+		return f.NilReplacement
 	}
 
-	if f.SkipDup(v) {
+	b, isNil, ok := getBool(v)
+	if !ok {
+		return fmt.Sprintf("bool expected (got: %T): %v", v, v)
+	}
+
+	var dupVal any = b
+	if isNil {
+		dupVal = nil
+	}
+
+	if f.SkipDup(dupVal) {
 		return ""
 	}
 
-	return fmt.Sprintf("%t", v)
+	switch {
+	case isNil:
+		return f.NilLabel
+	case b:
+		return f.trueLabel()
+	}
+
+	return f.falseLabel()
 }
 
-// Width returns the intended width of the value
+// Width returns the intended width of the value. This is the length of the
+// longest label if that is greater than W
 func (f Bool) Width() int {
-	return f.W
+	return max(f.W,
+		utf8.RuneCountInString(f.trueLabel()),
+		utf8.RuneCountInString(f.falseLabel()),
+		utf8.RuneCountInString(f.NilLabel))
 }
 
 // Just returns the justification of the value
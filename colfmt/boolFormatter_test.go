@@ -0,0 +1,121 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// namedBool is a bool type used to check that values of named bool types
+// are formatted as bools
+type namedBool bool
+
+func TestBoolFormatter(t *testing.T) {
+	bTrue := true
+
+	var bNil *bool
+
+	testCases := []struct {
+		testhelper.ID
+		bf     colfmt.Bool
+		val    any
+		expStr string
+		expW   int
+	}{
+		{
+			ID:     testhelper.MkID("default, true"),
+			val:    true,
+			expStr: "true",
+			expW:   5,
+		},
+		{
+			ID:     testhelper.MkID("default, false"),
+			val:    false,
+			expStr: "false",
+			expW:   5,
+		},
+		{
+			ID:     testhelper.MkID("default, named bool type"),
+			val:    namedBool(true),
+			expStr: "true",
+			expW:   5,
+		},
+		{
+			ID:     testhelper.MkID("labels, *bool"),
+			bf:     colfmt.Bool{TrueLabel: "yes", FalseLabel: "no"},
+			val:    &bTrue,
+			expStr: "yes",
+			expW:   3,
+		},
+		{
+			ID: testhelper.MkID("labels, nil *bool"),
+			bf: colfmt.Bool{
+				TrueLabel:  "Y",
+				FalseLabel: "N",
+				NilLabel:   "n/a",
+			},
+			val:    bNil,
+			expStr: "n/a",
+			expW:   3,
+		},
+		{
+			ID:     testhelper.MkID("labels, W is bigger"),
+			bf:     colfmt.Bool{W: 4, TrueLabel: "✓", FalseLabel: "✗"},
+			val:    false,
+			expStr: "✗",
+			expW:   4,
+		},
+		{
+			ID:     testhelper.MkID("string, 1"),
+			val:    "1",
+			expStr: "true",
+			expW:   5,
+		},
+		{
+			ID:     testhelper.MkID("string, Yes"),
+			val:    "Yes",
+			expStr: "true",
+			expW:   5,
+		},
+		{
+			ID:     testhelper.MkID("string, off"),
+			val:    "off",
+			expStr: "false",
+			expW:   5,
+		},
+		{
+			ID:     testhelper.MkID("string, bad"),
+			val:    "maybe",
+			expStr: "bool expected (got: string): maybe",
+			expW:   5,
+		},
+		{
+			ID:     testhelper.MkID("flag, true"),
+			bf:     colfmt.Bool{Flag: true},
+			val:    true,
+			expStr: "✓",
+			expW:   1,
+		},
+		{
+			ID:     testhelper.MkID("flag, false"),
+			bf:     colfmt.Bool{Flag: true, FalseLabel: "ignored"},
+			val:    false,
+			expStr: "",
+			expW:   1,
+		},
+		{
+			ID:     testhelper.MkID("flag, own marker"),
+			bf:     colfmt.Bool{Flag: true, TrueLabel: "rw"},
+			val:    "t",
+			expStr: "rw",
+			expW:   2,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			tc.bf.Formatted(tc.val), tc.expStr)
+		testhelper.DiffInt(t, tc.IDStr(), "width", tc.bf.Width(), tc.expW)
+	}
+}
@@ -0,0 +1,201 @@
+package colfmt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/mathutil.mod/v2/mathutil"
+)
+
+// ChangeMarker describes how the direction of a change is shown
+type ChangeMarker int
+
+// The ChangeMarker values:
+//
+//	ChangeSign means the change is preceded by a sign: +1.25% or -1.25%
+//	ChangeArrow means the change is preceded by an arrow: ▲1.25% or ▼1.25%
+const (
+	ChangeSign ChangeMarker = iota
+	ChangeArrow
+)
+
+// ChangeUnit describes the units in which a change is shown
+type ChangeUnit int
+
+// The ChangeUnit values:
+//
+//	ChangePct means the change is shown as a percentage: +0.25%
+//	ChangeBasisPts means the change is shown in basis points: +25bp
+const (
+	ChangePct ChangeUnit = iota
+	ChangeBasisPts
+)
+
+// dfltFlat is the value shown for a change which is below the FlatBelow
+// threshold if no other value is given.
+const dfltFlat = "flat"
+
+// bpPerPct is the number of basis points in one percent
+const bpPerPct = 100
+
+// Change records the values needed for the formatting of a proportional
+// change, such as a period-on-period change, as a percentage or in basis
+// points. As with the Percent Formatter, the value is expected to be a
+// proportion so a value of 0.0125 is shown as +1.25% (or +125bp). Unlike the
+// Percent Formatter, the direction of the change is always shown, except
+// for NaN values which have no direction.
+//
+// See [NilHdlr] and [ThresholdHdlr] for the settings that can be given
+// through those types.
+type Change struct {
+	// W gives the minimum space to be taken by the formatted value
+	W int
+	// Prec gives the precision with which to print the value when formatted
+	Prec int
+	// Marker gives the way in which the direction of the change is shown
+	Marker ChangeMarker
+	// Unit gives the units in which the change is shown
+	Unit ChangeUnit
+	// FlatBelow, if greater than zero, gives the size of change (as a
+	// proportion) below which the change is shown as the Flat string
+	FlatBelow float64
+	// Flat gives the value to be shown for changes smaller than the
+	// FlatBelow value. If it is not set then "flat" is shown
+	Flat string
+	// Zeroes records any desired special handling for zero values. As
+	// with the Percent Formatter this is applied to the value after it has
+	// been converted into the units to be shown
+	Zeroes *ZeroHandler
+
+	NilHdlr
+	ThresholdHdlr
+}
+
+// flat returns the string to be shown for a change below the FlatBelow
+// threshold
+func (f Change) flat() string {
+	if f.Flat == "" {
+		return dfltFlat
+	}
+
+	return f.Flat
+}
+
+// unitStr returns the string showing the unit of the change
+func (f Change) unitStr() string {
+	if f.Unit == ChangeBasisPts {
+		return "bp"
+	}
+
+	return "%"
+}
+
+// marker returns the string showing the direction of the change
+func (f Change) marker(neg bool) string {
+	switch {
+	case f.Marker == ChangeArrow && neg:
+		return "▼"
+	case f.Marker == ChangeArrow:
+		return "▲"
+	case neg:
+		return "-"
+	}
+
+	return "+"
+}
+
+// Formatted returns the value formatted as a change
+func (f *Change) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	if str, ok := f.Threshold(v); ok {
+		return str
+	}
+
+	f64, ok := getNumAsFloat64(v)
+	if !ok {
+		return fmt.Sprintf("Numeric value expected (got: %T): %v", v, v)
+	}
+
+	chg := mathutil.ToPercent(f64)
+	if f.Unit == ChangeBasisPts {
+		chg *= bpPerPct
+	}
+
+	if ok, str := f.Zeroes.GetZeroStr(f.Prec, chg); ok {
+		return fmt.Sprintf("%.*s", f.Width(), str)
+	}
+
+	if f.FlatBelow > 0 && math.Abs(f64) < f.FlatBelow {
+		return f.flat()
+	}
+
+	switch {
+	case math.IsNaN(chg):
+		return "NaN" + f.unitStr()
+	case math.IsInf(chg, 0):
+		return f.marker(chg < 0) + "Inf" + f.unitStr()
+	}
+
+	str := strconv.FormatFloat(math.Abs(chg), 'f', max(f.Prec, 0), 64)
+
+	if rounded, err := strconv.ParseFloat(str, 64); err == nil &&
+		rounded == 0 {
+		return str + f.unitStr()
+	}
+
+	return f.marker(chg < 0) + str + f.unitStr()
+}
+
+// Width returns the intended width of the value. An invalid width or one
+// incompatible with the given precision is ignored
+func (f Change) Width() int {
+	minWidth := 2 + utf8.RuneCountInString(f.unitStr()) //nolint:mnd
+	if f.Prec > 0 {
+		minWidth++         // for the decimal place
+		minWidth += f.Prec // for the precision digits
+	}
+
+	if f.FlatBelow > 0 {
+		minWidth = max(minWidth, utf8.RuneCountInString(f.flat()))
+	}
+
+	return max(minWidth, f.W, f.thresholdWidth())
+}
+
+// Just returns the justification of the value
+func (f Change) Just() col.Justification {
+	return col.Right
+}
+
+// Check returns a non-nil error if the Marker or Unit is invalid or if the
+// FlatBelow value is negative
+func (f Change) Check() error {
+	switch f.Marker {
+	case ChangeSign, ChangeArrow:
+	default:
+		return fmt.Errorf("%T: bad Marker: %d", f, f.Marker)
+	}
+
+	switch f.Unit {
+	case ChangePct, ChangeBasisPts:
+	default:
+		return fmt.Errorf("%T: bad Unit: %d", f, f.Unit)
+	}
+
+	if f.FlatBelow < 0 {
+		return fmt.Errorf("%T: the FlatBelow value (%g) must not be negative",
+			f, f.FlatBelow)
+	}
+
+	if err := f.checkThresholds(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
+	return nil
+}
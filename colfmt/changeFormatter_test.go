@@ -0,0 +1,134 @@
+package colfmt_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestChangeFormatter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		cf     colfmt.Change
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("basic, rise"),
+			cf:     colfmt.Change{Prec: 2},
+			val:    0.0125,
+			expStr: "+1.25%",
+		},
+		{
+			ID:     testhelper.MkID("basic, fall"),
+			cf:     colfmt.Change{Prec: 2},
+			val:    -0.0125,
+			expStr: "-1.25%",
+		},
+		{
+			ID:     testhelper.MkID("basic, rounds to zero"),
+			cf:     colfmt.Change{Prec: 1},
+			val:    -0.0000001,
+			expStr: "0.0%",
+		},
+		{
+			ID:     testhelper.MkID("arrows"),
+			cf:     colfmt.Change{Prec: 1, Marker: colfmt.ChangeArrow},
+			val:    -0.125,
+			expStr: "▼12.5%",
+		},
+		{
+			ID:     testhelper.MkID("NaN"),
+			cf:     colfmt.Change{Prec: 1},
+			val:    math.NaN(),
+			expStr: "NaN%",
+		},
+		{
+			ID:     testhelper.MkID("NaN, arrows"),
+			cf:     colfmt.Change{Prec: 1, Marker: colfmt.ChangeArrow},
+			val:    math.NaN(),
+			expStr: "NaN%",
+		},
+		{
+			ID:     testhelper.MkID("+Inf"),
+			cf:     colfmt.Change{Prec: 1},
+			val:    math.Inf(1),
+			expStr: "+Inf%",
+		},
+		{
+			ID:     testhelper.MkID("-Inf, arrows"),
+			cf:     colfmt.Change{Prec: 1, Marker: colfmt.ChangeArrow},
+			val:    math.Inf(-1),
+			expStr: "▼Inf%",
+		},
+		{
+			ID:     testhelper.MkID("basis points"),
+			cf:     colfmt.Change{Unit: colfmt.ChangeBasisPts},
+			val:    0.0025,
+			expStr: "+25bp",
+		},
+		{
+			ID:     testhelper.MkID("flat"),
+			cf:     colfmt.Change{FlatBelow: 0.001},
+			val:    -0.0009,
+			expStr: "flat",
+		},
+		{
+			ID:     testhelper.MkID("flat, bespoke"),
+			cf:     colfmt.Change{FlatBelow: 0.001, Flat: "~0%"},
+			val:    0.0009,
+			expStr: "~0%",
+		},
+		{
+			ID: testhelper.MkID("zero handling"),
+			cf: colfmt.Change{
+				Prec: 1,
+				Zeroes: &colfmt.FloatZeroHandler{
+					Handle:  true,
+					Replace: "-",
+				},
+			},
+			val:    0.0004999,
+			expStr: "-",
+		},
+		{
+			ID:     testhelper.MkID("not a number"),
+			val:    "x",
+			expStr: "Numeric value expected (got: string): x",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.cf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
+
+func TestChangeWidth(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		cf       colfmt.Change
+		expWidth int
+	}{
+		{
+			ID:       testhelper.MkID("default"),
+			expWidth: 3,
+		},
+		{
+			ID:       testhelper.MkID("basis points, with precision"),
+			cf:       colfmt.Change{Prec: 1, Unit: colfmt.ChangeBasisPts},
+			expWidth: 6,
+		},
+		{
+			ID:       testhelper.MkID("flat"),
+			cf:       colfmt.Change{FlatBelow: 0.1, Flat: "unchanged"},
+			expWidth: 9,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "width", tc.cf.Width(), tc.expWidth)
+	}
+}
@@ -1,5 +1,7 @@
 package colfmt
 
+import "github.com/nickwells/col.mod/v6/col"
+
 // DupHdlr encapsulates all the parts needed to support the suppression of the
 // printing of duplicate values
 type DupHdlr struct {
@@ -7,6 +9,10 @@ type DupHdlr struct {
 	// previously printed value print as the empty string (or some other
 	// value chosen by the formatter using this).
 	SkipDups bool
+	// Equal, if set, is used to compare the value with the previous
+	// value. If it is not set then col.ValsEqual is used which, unlike a
+	// simple comparison, will not panic if the values are not comparable
+	Equal col.EqualFunc
 
 	previousValue         any
 	previousValueRecorded bool
@@ -24,7 +30,12 @@ func (dh *DupHdlr) SkipDup(v any) bool {
 		return false
 	}
 
-	if dh.previousValueRecorded && v == dh.previousValue {
+	eq := dh.Equal
+	if eq == nil {
+		eq = col.ValsEqual
+	}
+
+	if dh.previousValueRecorded && eq(v, dh.previousValue) {
 		return true
 	}
 
@@ -33,3 +44,33 @@ func (dh *DupHdlr) SkipDup(v any) bool {
 
 	return false
 }
+
+// Reset forgets the previous value so that the next value will not be
+// treated as a duplicate
+func (dh *DupHdlr) Reset() {
+	dh.previousValue = nil
+	dh.previousValueRecorded = false
+}
+
+// dupState records the state of a DupHdlr
+type dupState struct {
+	previousValue         any
+	previousValueRecorded bool
+}
+
+// SaveState returns the previous value so that it can be restored
+func (dh *DupHdlr) SaveState() any {
+	return dupState{
+		previousValue:         dh.previousValue,
+		previousValueRecorded: dh.previousValueRecorded,
+	}
+}
+
+// RestoreState restores the previous value from a value returned by
+// SaveState
+func (dh *DupHdlr) RestoreState(state any) {
+	if ds, ok := state.(dupState); ok {
+		dh.previousValue = ds.previousValue
+		dh.previousValueRecorded = ds.previousValueRecorded
+	}
+}
@@ -0,0 +1,65 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestDupHdlr(t *testing.T) {
+	sameLen := func(a, b any) bool {
+		as, aok := a.(string)
+		bs, bok := b.(string)
+
+		return aok && bok && len(as) == len(bs)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		dh      colfmt.DupHdlr
+		vals    []any
+		expDups []bool
+	}{
+		{
+			ID:      testhelper.MkID("not skipping"),
+			vals:    []any{1, 1},
+			expDups: []bool{false, false},
+		},
+		{
+			ID:      testhelper.MkID("comparable values"),
+			dh:      colfmt.DupHdlr{SkipDups: true},
+			vals:    []any{1, 1, 2, int64(2)},
+			expDups: []bool{false, true, false, false},
+		},
+		{
+			ID:      testhelper.MkID("non-comparable values"),
+			dh:      colfmt.DupHdlr{SkipDups: true},
+			vals:    []any{[]int{1}, []int{1}, []int{2}},
+			expDups: []bool{false, true, false},
+		},
+		{
+			ID:      testhelper.MkID("custom equality"),
+			dh:      colfmt.DupHdlr{SkipDups: true, Equal: sameLen},
+			vals:    []any{"ab", "cd", "efg"},
+			expDups: []bool{false, true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		for i, v := range tc.vals {
+			if isDup := tc.dh.SkipDup(v); isDup != tc.expDups[i] {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: value[%d]: expected SkipDup: %t, got: %t",
+					i, tc.expDups[i], isDup)
+			}
+		}
+
+		tc.dh.Reset()
+
+		if tc.dh.SkipDup(tc.vals[len(tc.vals)-1]) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: after Reset the last value is still a duplicate")
+		}
+	}
+}
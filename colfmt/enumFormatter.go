@@ -0,0 +1,140 @@
+package colfmt
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// Enum records the values needed for the formatting of a value from a small
+// set of values (such as a status code) as a label.
+//
+// See [NilHdlr] and [DupHdlr] for the settings that can be given through
+// those types.
+type Enum struct {
+	// W gives the minimum width of the label that should be printed. The
+	// width will always be at least as wide as the longest label
+	W int
+	// Labels maps each of the expected values to the label to be printed
+	Labels map[any]string
+	// Default is the label to be printed for any value not found in the
+	// Labels map. If it is not set then the value is printed as is
+	Default string
+	// Order gives the values in their declared order. It is used by the Cmp
+	// method and need not be set if you don't need to sort by the value.
+	Order []any
+	// StrJust gives the justification to be used
+	StrJust col.Justification
+
+	NilHdlr
+	DupHdlr
+}
+
+// label returns the label for the value and a flag indicating whether it was
+// found.
+func (f Enum) label(v any) (string, bool) {
+	if v != nil && !reflect.TypeOf(v).Comparable() {
+		return "", false
+	}
+
+	lbl, ok := f.Labels[v]
+
+	return lbl, ok
+}
+
+// text returns the text to be shown for the value. This is the label if the
+// value is in the Labels map, otherwise the Default or, if that is not
+// set, the value itself.
+func (f Enum) text(v any) string {
+	if lbl, ok := f.label(v); ok {
+		return lbl
+	}
+
+	if f.Default == "" {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return f.Default
+}
+
+// Formatted returns the label for the value
+func (f *Enum) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	if f.SkipDup(v) {
+		return ""
+	}
+
+	return f.text(v)
+}
+
+// Width returns the intended width of the value. This is the greater of
+// the W value and the length of the longest label
+func (f Enum) Width() int {
+	w := max(f.W, utf8.RuneCountInString(f.Default))
+
+	for _, lbl := range f.Labels {
+		w = max(w, utf8.RuneCountInString(lbl))
+	}
+
+	return w
+}
+
+// Just returns the justification of the value
+func (f Enum) Just() col.Justification {
+	return f.StrJust
+}
+
+// Check returns a non-nil error if there are no Labels or if any of the
+// values in the Order has no label
+func (f Enum) Check() error {
+	if len(f.Labels) == 0 {
+		return fmt.Errorf("%T: no Labels have been given", f)
+	}
+
+	var errs []error
+
+	for i, v := range f.Order {
+		if _, ok := f.label(v); !ok {
+			errs = append(errs,
+				fmt.Errorf("%T: Order[%d] (%v) has no label", f, i, v))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// orderIdx returns the index of the value in the Order slice or the length
+// of the Order slice if it is not present.
+func (f Enum) orderIdx(v any) int {
+	if v != nil && !reflect.TypeOf(v).Comparable() {
+		return len(f.Order)
+	}
+
+	if idx := slices.Index(f.Order, v); idx >= 0 {
+		return idx
+	}
+
+	return len(f.Order)
+}
+
+// Cmp compares the two values according to their position in the Order
+// slice. It returns a negative value if a comes before b, a positive value
+// if it comes after b and zero if they are at the same position. Values
+// which are not in the Order slice are placed after those that are and are
+// compared by their labels.
+func (f Enum) Cmp(a, b any) int {
+	aIdx, bIdx := f.orderIdx(a), f.orderIdx(b)
+	if aIdx != bIdx || aIdx < len(f.Order) {
+		return aIdx - bIdx
+	}
+
+	return strings.Compare(f.text(a), f.text(b))
+}
@@ -0,0 +1,139 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+var statusLabels = map[any]string{
+	0: "ok",
+	1: "warning",
+	2: "error",
+}
+
+func TestEnumFormatter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		ef     colfmt.Enum
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("known value"),
+			ef:     colfmt.Enum{Labels: statusLabels},
+			val:    1,
+			expStr: "warning",
+		},
+		{
+			ID:     testhelper.MkID("unknown value, no default"),
+			ef:     colfmt.Enum{Labels: statusLabels},
+			val:    7,
+			expStr: "7",
+		},
+		{
+			ID:     testhelper.MkID("unknown value, with default"),
+			ef:     colfmt.Enum{Labels: statusLabels, Default: "unknown"},
+			val:    7,
+			expStr: "unknown",
+		},
+		{
+			ID:     testhelper.MkID("non-comparable value, with default"),
+			ef:     colfmt.Enum{Labels: statusLabels, Default: "unknown"},
+			val:    []int{1},
+			expStr: "unknown",
+		},
+		{
+			ID: testhelper.MkID("ignore nil, pass nil"),
+			ef: colfmt.Enum{
+				Labels:  statusLabels,
+				NilHdlr: colfmt.NilHdlr{IgnoreNil: true},
+			},
+			expStr: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.ef.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
+
+func TestEnumWidth(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		ef       colfmt.Enum
+		expWidth int
+	}{
+		{
+			ID:       testhelper.MkID("from labels"),
+			ef:       colfmt.Enum{Labels: statusLabels},
+			expWidth: 7,
+		},
+		{
+			ID:       testhelper.MkID("from default"),
+			ef:       colfmt.Enum{Labels: statusLabels, Default: "not known"},
+			expWidth: 9,
+		},
+		{
+			ID:       testhelper.MkID("from W"),
+			ef:       colfmt.Enum{Labels: statusLabels, W: 10},
+			expWidth: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "width", tc.ef.Width(), tc.expWidth)
+	}
+}
+
+func TestEnumCmp(t *testing.T) {
+	ef := colfmt.Enum{
+		Labels: statusLabels,
+		Order:  []any{2, 0, 1},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		a, b   any
+		expCmp int
+	}{
+		{
+			ID:     testhelper.MkID("in order"),
+			a:      2,
+			b:      0,
+			expCmp: -1,
+		},
+		{
+			ID:     testhelper.MkID("out of order"),
+			a:      1,
+			b:      2,
+			expCmp: 1,
+		},
+		{
+			ID:     testhelper.MkID("same"),
+			a:      1,
+			b:      1,
+			expCmp: 0,
+		},
+		{
+			ID:     testhelper.MkID("unknown after known"),
+			a:      9,
+			b:      1,
+			expCmp: 1,
+		},
+		{
+			ID:     testhelper.MkID("unknown values, by label"),
+			a:      8,
+			b:      9,
+			expCmp: -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		cmp := ef.Cmp(tc.a, tc.b)
+		testhelper.DiffInt(t, tc.IDStr(), "comparison",
+			max(-1, min(cmp, 1)), tc.expCmp)
+	}
+}
@@ -9,29 +9,61 @@ import (
 )
 
 // Float records the values needed for the formatting of a float(64/32)
-// value.
+// value. Values of any of the integer types are converted to float64.
 //
-// See [NilHdlr] for the settings that can be given through that type.
+// See [NilHdlr] and [ThresholdHdlr] for the settings that can be given
+// through those types.
 type Float struct {
 	// W gives the minimum space to be taken by the formatted value
 	W int
 	// Prec gives the precision with which to print the value when formatted
-	// Negative values are treated as zero
+	// Negative values are treated as zero. When the Style is FloatSigFigs it
+	// gives the number of places to be reserved after the decimal point so
+	// that the decimal points can be aligned
 	Prec int
 	// Zeroes records any desired special handling for zero values
-	Zeroes *FloatZeroHandler
+	Zeroes *ZeroHandler
 	// Verb specifies the formatting verb. If left unset it will use
-	// 'f'. There will be a panic if it is not one of 'eEfFgGxX'
+	// 'f'. If it is not one of 'eEfFgGxX' then the Formatted method will
+	// panic and the FormattedE method will return an error
 	Verb rune
 	// TrimTrailingZeroes removes any trailing zeroes after the decimal
 	// point. It leaves a zero immediately after the point
 	TrimTrailingZeroes bool
 	// ReformatOutOfBoundValues will generate a new format to be used if the
 	// passed value is too big or too small to be shown in the space
-	// available
+	// available. Use col.Col.SetDecAlign to keep the decimal points of such
+	// values aligned
 	ReformatOutOfBoundValues bool
+	// Sign gives the way that the sign of the value is shown. If it is not
+	// set then negative values have a leading minus sign. Any extra space
+	// needed to show the sign is added to the width
+	Sign SignStyle
+	// Style gives the way in which the value is shown. If it is not set
+	// then the value is formatted using the Verb and the Prec. See
+	// [FloatStyle] for the available styles.
+	Style FloatStyle
+	// SigFigs gives the number of significant figures to be shown when the
+	// Style is FloatEng, FloatSI or FloatSigFigs. If it is less than 1 then
+	// 3 significant figures are shown
+	SigFigs int
+	// Unit gives a suffix to be shown after the value (and after any SI
+	// prefix) when the Style is FloatEng or FloatSI. For instance, "B" or
+	// "Hz"
+	Unit string
 
 	NilHdlr
+	ThresholdHdlr
+}
+
+// checkVerb returns a non-nil error if the Verb is invalid
+func (f Float) checkVerb() error {
+	switch f.Verb {
+	case 0, 'f', 'F', 'e', 'E', 'g', 'G', 'x', 'X':
+		return nil
+	}
+
+	return fmt.Errorf("%T: bad Format verb: %q", f, f.Verb)
 }
 
 // makeFormat returns a format string to be used to report the value. It uses
@@ -52,37 +84,42 @@ func (f Float) makeFormat(v any) string {
 					f.Prec, math.MaxInt))
 			}
 
-			if f.Width() > math.MaxInt {
+			if f.numWidth() > math.MaxInt {
 				panic(fmt.Errorf(
 					"the width (%d) is too big, the maximum value is %d",
-					f.Width(), math.MaxInt))
+					f.numWidth(), math.MaxInt))
 			}
 
-			f64, ok := getValAsFloat64(v)
+			f64, ok := getNumAsFloat64(v)
 			if ok &&
 				(f64 < math.Pow10(-f.Prec) ||
-					f64 > math.Pow10(f.Width()-f.Prec)) {
+					f64 > math.Pow10(f.numWidth()-f.Prec)) {
 				format = "%.*g"
 			}
 		}
 	case 'e', 'E', 'g', 'G', 'x', 'X':
 		format = "%.*" + string(f.Verb)
 	default:
-		panic(fmt.Errorf("%T: bad Format verb: %q", f, f.Verb))
+		panic(f.checkVerb())
 	}
 
 	return format
 }
 
 // trimTrailingZeros removes any trailing zeros after the decimal point
-// (except the one immediately following)
+// (except the one immediately following). Values without a decimal point
+// or with an exponent are left unchanged
 func (f Float) trimTrailingZeros(s string) string {
 	if !f.TrimTrailingZeroes {
 		return s
 	}
 
-	r := []rune(s)
 	postPointIdx := strings.LastIndex(s, ".")
+	if postPointIdx < 0 || strings.ContainsAny(s[postPointIdx:], "eEpP") {
+		return s
+	}
+
+	r := []rune(s)
 
 	for i := len(s) - 1; i > postPointIdx+1; i-- {
 		if r[i] == '0' {
@@ -95,24 +132,101 @@ func (f Float) trimTrailingZeros(s string) string {
 	return string(r)
 }
 
-// Formatted returns the value formatted as a float
+// Formatted returns the value formatted as a float. It will panic if the
+// Verb is invalid.
 func (f *Float) Formatted(v any) string {
+	if f.Style == FloatStd {
+		if err := f.checkVerb(); err != nil {
+			panic(err)
+		}
+	}
+
+	s, _ := f.FormattedE(v)
+
+	return s
+}
+
+// FormattedE returns the value formatted as a float. If the value is not
+// numeric or the Verb is invalid a non-nil error is returned together with
+// a description of the value.
+func (f *Float) FormattedE(v any) (string, error) {
 	if f.SkipNil(v) {
-		return ""
+		return f.NilReplacement, nil
+	}
+
+	if str, ok := f.Threshold(v); ok {
+		return f.Sign.padUnsigned(str), nil
+	}
+
+	f64, isNum := getNumAsFloat64(v)
+
+	var err error
+	if !isNum {
+		err = fmt.Errorf("%T: numeric value expected (got: %T): %v", *f, v, v)
+	}
+
+	if f.Style != FloatStd {
+		if ok, str := f.Zeroes.getExactZeroStr(v); ok {
+			return f.Sign.padUnsigned(
+				fmt.Sprintf("%.*s", f.numWidth(), str)), nil
+		}
+
+		if !isNum {
+			return fmt.Sprintf("%f", v), err
+		}
+
+		if f.Style == FloatSigFigs {
+			return f.sigFigsFormatted(f64), nil
+		}
+
+		return f.engFormatted(f64), nil
+	}
+
+	if verbErr := f.checkVerb(); verbErr != nil {
+		return fmt.Sprintf("%"+string(f.Verb), v), verbErr
 	}
 
 	format := f.makeFormat(v)
 
+	if !isNum {
+		return fmt.Sprintf(format, f.Prec, v), err
+	}
+
 	if ok, str := f.Zeroes.GetZeroStr(f.Prec, v); ok {
-		return fmt.Sprintf("%.*s", f.Width(), str)
+		return f.Sign.padUnsigned(fmt.Sprintf("%.*s", f.numWidth(), str)), nil
+	}
+
+	var val any = f64
+	if _, isF32 := v.(float32); isF32 {
+		val = v
 	}
 
-	return f.trimTrailingZeros(fmt.Sprintf(format, f.Prec, v))
+	if f.Zeroes.isNegZero(f.Prec, f64) {
+		val, f64 = 0.0, 0
+	}
+
+	return f.Sign.apply(
+		f.trimTrailingZeros(fmt.Sprintf(format, f.Prec, val)),
+		f64 == 0), nil
 }
 
 // Width returns the intended width of the value. An invalid width or one
 // incompatible with the given precision is ignored
 func (f Float) Width() int {
+	return max(f.numWidth(), f.thresholdWidth()) + f.Sign.extraWidth()
+}
+
+// numWidth returns the intended width of the value without any extra space
+// needed for the sign
+func (f Float) numWidth() int {
+	switch f.Style {
+	case FloatStd:
+	case FloatSigFigs:
+		return max(f.W, f.sigFigsWidth())
+	default:
+		return max(f.W, f.engWidth())
+	}
+
 	minWidth := 1
 	if f.Prec > 0 {
 		minWidth++ // for the decimal place
@@ -131,12 +245,23 @@ func (f Float) Just() col.Justification {
 	return col.Right
 }
 
-// Check returns a non-nil error if the Formatter has an invalid Verb
+// Check returns a non-nil error if the Formatter has an invalid Verb, Sign
+// or Style
 func (f Float) Check() error {
-	switch f.Verb {
-	case 0, 'f', 'F', 'e', 'E', 'g', 'G', 'x', 'X':
-	default:
-		return fmt.Errorf("%T: bad Format verb: %q", f, f.Verb)
+	if err := f.checkVerb(); err != nil {
+		return err
+	}
+
+	if err := f.Sign.check(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
+	if err := f.Style.check(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
+	if err := f.checkThresholds(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
 	}
 
 	return nil
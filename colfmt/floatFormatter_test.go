@@ -1,12 +1,17 @@
 package colfmt_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/nickwells/col.mod/v6/colfmt"
 	"github.com/nickwells/testhelper.mod/v2/testhelper"
 )
 
+// money is a named float type used to check that values of named numeric
+// types are formatted as numbers
+type money float64
+
 func TestFloatFormatter(t *testing.T) {
 	testCases := []struct {
 		testhelper.ID
@@ -34,12 +39,98 @@ func TestFloatFormatter(t *testing.T) {
 			ff:     colfmt.Float{NilHdlr: colfmt.NilHdlr{IgnoreNil: true}},
 			expStr: "",
 		},
+		{
+			ID:     testhelper.MkID("named float type"),
+			ff:     colfmt.Float{Prec: 2},
+			val:    money(1.5),
+			expStr: "1.50",
+		},
 		{
 			ID:     testhelper.MkID("with precision"),
 			ff:     colfmt.Float{Prec: 2},
 			val:    1.2345,
 			expStr: "1.23",
 		},
+		{
+			ID:     testhelper.MkID("pass an int"),
+			ff:     colfmt.Float{Prec: 1},
+			val:    42,
+			expStr: "42.0",
+		},
+		{
+			ID: testhelper.MkID("zero handling, pass an int"),
+			ff: colfmt.Float{
+				Prec:   2,
+				Zeroes: &colfmt.ZeroHandler{Handle: true, Replace: "-"},
+			},
+			val:    uint8(0),
+			expStr: "-",
+		},
+		{
+			ID: testhelper.MkID("zero handling, near zero, separate"),
+			ff: colfmt.Float{
+				Prec: 2,
+				Zeroes: &colfmt.ZeroHandler{
+					Handle:           true,
+					Replace:          "0",
+					SeparateNearZero: true,
+					NearZeroReplace:  "~0",
+				},
+			},
+			val:    0.001,
+			expStr: "~0",
+		},
+		{
+			ID: testhelper.MkID("zero handling, exact zero, separate"),
+			ff: colfmt.Float{
+				Prec: 2,
+				Zeroes: &colfmt.ZeroHandler{
+					Handle:           true,
+					Replace:          "0",
+					SeparateNearZero: true,
+					NearZeroReplace:  "~0",
+				},
+			},
+			val:    0.0,
+			expStr: "0",
+		},
+		{
+			ID: testhelper.MkID("zero handling, epsilon override"),
+			ff: colfmt.Float{
+				Prec: 2,
+				Zeroes: &colfmt.ZeroHandler{
+					Handle:  true,
+					Replace: "small",
+					Epsilon: 0.5,
+				},
+			},
+			val:    -0.25,
+			expStr: "smal",
+		},
+		{
+			ID:     testhelper.MkID("negative zero, not normalised"),
+			ff:     colfmt.Float{Prec: 2},
+			val:    -0.001,
+			expStr: "-0.00",
+		},
+		{
+			ID: testhelper.MkID("negative zero, normalised"),
+			ff: colfmt.Float{
+				Prec:   2,
+				Zeroes: &colfmt.ZeroHandler{NormaliseNegZero: true},
+			},
+			val:    -0.001,
+			expStr: "0.00",
+		},
+		{
+			ID: testhelper.MkID("negative value, normalised"),
+			ff: colfmt.Float{
+				Prec:   2,
+				Zeroes: &colfmt.ZeroHandler{NormaliseNegZero: true},
+			},
+			val:    -0.01,
+			expStr: "-0.01",
+		},
 		{
 			ID: testhelper.MkID("with zero handling, large (just) value"),
 			ff: colfmt.Float{
@@ -140,6 +231,206 @@ func TestFloatFormatter(t *testing.T) {
 			val:    float32(0.0),
 			expStr: "",
 		},
+		{
+			ID:     testhelper.MkID("sign style: trailing, negative"),
+			ff:     colfmt.Float{Prec: 2, Sign: colfmt.SignTrailing},
+			val:    -1.5,
+			expStr: "1.50-",
+		},
+		{
+			ID:     testhelper.MkID("sign style: trailing, positive"),
+			ff:     colfmt.Float{Prec: 2, Sign: colfmt.SignTrailing},
+			val:    1.5,
+			expStr: "1.50 ",
+		},
+		{
+			ID:     testhelper.MkID("sign style: parens, negative"),
+			ff:     colfmt.Float{Prec: 2, Sign: colfmt.SignParens},
+			val:    -1234.5,
+			expStr: "(1234.50)",
+		},
+		{
+			ID: testhelper.MkID(
+				"sign style: parens, negative, trim trailing zeroes"),
+			ff: colfmt.Float{
+				Prec:               3,
+				Sign:               colfmt.SignParens,
+				TrimTrailingZeroes: true,
+			},
+			val:    -1.5,
+			expStr: "(1.5)  ",
+		},
+		{
+			ID:     testhelper.MkID("sign style: parens, positive"),
+			ff:     colfmt.Float{Prec: 2, Sign: colfmt.SignParens},
+			val:    1234.5,
+			expStr: "1234.50 ",
+		},
+		{
+			ID: testhelper.MkID("sign style: parens, zero replaced"),
+			ff: colfmt.Float{
+				Prec: 2,
+				Sign: colfmt.SignParens,
+				Zeroes: &colfmt.FloatZeroHandler{
+					Handle:  true,
+					Replace: "-",
+				},
+			},
+			val:    0.0,
+			expStr: "- ",
+		},
+		{
+			ID:     testhelper.MkID("sign style: always, positive"),
+			ff:     colfmt.Float{Prec: 1, Sign: colfmt.SignAlways},
+			val:    1.25,
+			expStr: "+1.2",
+		},
+		{
+			ID:     testhelper.MkID("sign style: always, zero"),
+			ff:     colfmt.Float{Prec: 1, Sign: colfmt.SignAlways},
+			val:    0.0,
+			expStr: "0.0",
+		},
+		{
+			ID:     testhelper.MkID("style: engineering"),
+			ff:     colfmt.Float{Style: colfmt.FloatEng},
+			val:    12345.0,
+			expStr: " 12.3e+03",
+		},
+		{
+			ID:     testhelper.MkID("style: engineering, small, 2 s.f."),
+			ff:     colfmt.Float{Style: colfmt.FloatEng, SigFigs: 2},
+			val:    0.000789,
+			expStr: " 790e-06",
+		},
+		{
+			ID:     testhelper.MkID("style: engineering, rounds up"),
+			ff:     colfmt.Float{Style: colfmt.FloatEng},
+			val:    999.6,
+			expStr: " 1.00e+03",
+		},
+		{
+			ID:     testhelper.MkID("style: SI, kilo"),
+			ff:     colfmt.Float{Style: colfmt.FloatSI},
+			val:    12345.0,
+			expStr: "    12.3k",
+		},
+		{
+			ID:     testhelper.MkID("style: SI, micro, with unit"),
+			ff:     colfmt.Float{Style: colfmt.FloatSI, Unit: "s"},
+			val:    0.000789,
+			expStr: "     789µs",
+		},
+		{
+			ID:     testhelper.MkID("style: SI, no prefix, negative"),
+			ff:     colfmt.Float{Style: colfmt.FloatSI, Unit: "B"},
+			val:    -1.5,
+			expStr: "   -1.50 B",
+		},
+		{
+			ID:     testhelper.MkID("style: SI, mega, int value"),
+			ff:     colfmt.Float{Style: colfmt.FloatSI, SigFigs: 4},
+			val:    4560000,
+			expStr: "    4.560M",
+		},
+		{
+			ID:     testhelper.MkID("style: SI, out of prefix range"),
+			ff:     colfmt.Float{Style: colfmt.FloatSI, Unit: "B"},
+			val:    1e30,
+			expStr: " 1.00e+30B",
+		},
+		{
+			ID:     testhelper.MkID("style: engineering, NaN"),
+			ff:     colfmt.Float{Style: colfmt.FloatEng},
+			val:    math.NaN(),
+			expStr: "      NaN",
+		},
+		{
+			ID:     testhelper.MkID("style: engineering, +Inf"),
+			ff:     colfmt.Float{Style: colfmt.FloatEng},
+			val:    math.Inf(1),
+			expStr: "      Inf",
+		},
+		{
+			ID:     testhelper.MkID("style: SI, -Inf, with unit"),
+			ff:     colfmt.Float{Style: colfmt.FloatSI, Unit: "s"},
+			val:    math.Inf(-1),
+			expStr: "     -Infs",
+		},
+		{
+			ID:     testhelper.MkID("style: sig figs, small"),
+			ff:     colfmt.Float{Style: colfmt.FloatSigFigs},
+			val:    0.000123,
+			expStr: "0.000123",
+		},
+		{
+			ID:     testhelper.MkID("style: sig figs, large"),
+			ff:     colfmt.Float{Style: colfmt.FloatSigFigs},
+			val:    123456,
+			expStr: "123000",
+		},
+		{
+			ID:     testhelper.MkID("style: sig figs, too large to fit"),
+			ff:     colfmt.Float{Style: colfmt.FloatSigFigs},
+			val:    1.5e30,
+			expStr: "1.50e+30",
+		},
+		{
+			ID: testhelper.MkID("style: sig figs, aligned"),
+			ff: colfmt.Float{
+				Style: colfmt.FloatSigFigs,
+				W:     10,
+				Prec:  4,
+			},
+			val:    -12.3456,
+			expStr: "-12.3   ",
+		},
+		{
+			ID: testhelper.MkID("style: sig figs, aligned, too big to fit"),
+			ff: colfmt.Float{
+				Style: colfmt.FloatSigFigs,
+				W:     10,
+				Prec:  4,
+			},
+			val:    123456,
+			expStr: "1.23e+05",
+		},
+		{
+			ID: testhelper.MkID("style: sig figs, trim trailing zeroes"),
+			ff: colfmt.Float{
+				Style:              colfmt.FloatSigFigs,
+				SigFigs:            4,
+				W:                  10,
+				Prec:               4,
+				TrimTrailingZeroes: true,
+			},
+			val:    1.5,
+			expStr: "1.5   ",
+		},
+		{
+			ID: testhelper.MkID("style: sig figs, zero replaced"),
+			ff: colfmt.Float{
+				Style: colfmt.FloatSigFigs,
+				Zeroes: &colfmt.FloatZeroHandler{
+					Handle:  true,
+					Replace: "zero",
+				},
+			},
+			val:    0.0,
+			expStr: "zero",
+		},
+		{
+			ID: testhelper.MkID("style: sig figs, small value not replaced"),
+			ff: colfmt.Float{
+				Style: colfmt.FloatSigFigs,
+				Zeroes: &colfmt.FloatZeroHandler{
+					Handle:  true,
+					Replace: "zero",
+				},
+			},
+			val:    0.0001,
+			expStr: "0.000100",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -173,9 +464,54 @@ func TestFloatWidth(t *testing.T) {
 			ff:       colfmt.Float{W: 5, Prec: 2},
 			expWidth: 5,
 		},
+		{
+			ID:       testhelper.MkID("sign style: parens"),
+			ff:       colfmt.Float{W: 5, Prec: 2, Sign: colfmt.SignParens},
+			expWidth: 7,
+		},
+		{
+			ID:       testhelper.MkID("sign style: trailing"),
+			ff:       colfmt.Float{W: 5, Prec: 2, Sign: colfmt.SignTrailing},
+			expWidth: 6,
+		},
+		{
+			ID:       testhelper.MkID("style: engineering"),
+			ff:       colfmt.Float{Style: colfmt.FloatEng},
+			expWidth: 9,
+		},
+		{
+			ID:       testhelper.MkID("style: SI, with unit"),
+			ff:       colfmt.Float{Style: colfmt.FloatSI, Unit: "Hz"},
+			expWidth: 11,
+		},
+		{
+			ID:       testhelper.MkID("style: sig figs"),
+			ff:       colfmt.Float{Style: colfmt.FloatSigFigs, SigFigs: 2},
+			expWidth: 8,
+		},
 	}
 
 	for _, tc := range testCases {
 		testhelper.DiffInt(t, tc.IDStr(), "width", tc.ff.Width(), tc.expWidth)
 	}
 }
+
+func TestFloatBadVerb(t *testing.T) {
+	f := colfmt.Float{Verb: 'd'}
+
+	s, err := f.FormattedE(1.5)
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("FormattedE, bad verb"),
+		ExpErr: testhelper.MkExpErr(`colfmt.Float: bad Format verb: 'd'`),
+	})
+	testhelper.DiffString(t, "FormattedE, bad verb", "formatted value",
+		s, "%!d(float64=1.5)")
+
+	panicked, _ := testhelper.PanicSafe(func() { f.Formatted(1.5) })
+	if !panicked {
+		t.Error("Formatted should panic when the verb is bad")
+	}
+}
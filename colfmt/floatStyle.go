@@ -0,0 +1,221 @@
+package colfmt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FloatStyle describes the way in which a Float Formatter shows its value
+type FloatStyle int
+
+// The FloatStyle values:
+//
+//	FloatStd means the value is formatted using the Verb and Prec
+//	FloatEng means engineering notation is used; the exponent is always
+//	    a multiple of 3: 12.3e+03
+//	FloatSI means the exponent is shown as an SI prefix: 12.3k
+//	FloatSigFigs means the value is shown to a fixed number of significant
+//	    figures using either fixed or exponent notation, whichever fits
+//	    into the width
+//
+// The FloatEng and FloatSI styles show the value with a fixed number of
+// significant figures and produce fixed-width output so that values of
+// very different magnitudes still line up. Values too large or too small to
+// be shown with an SI prefix are shown in engineering notation.
+const (
+	FloatStd FloatStyle = iota
+	FloatEng
+	FloatSI
+	FloatSigFigs
+)
+
+const (
+	// dfltSigFigs is the number of significant figures to be shown if no
+	// other value is given
+	dfltSigFigs = 3
+	// engExpStep is the step between exponents in engineering notation
+	engExpStep = 3
+	// engExpWidth is the (usual) width of the exponent when the value is
+	// shown in engineering notation
+	engExpWidth = 4
+	// engMantMax is the value at which the mantissa of a number in
+	// engineering notation overflows into the next exponent
+	engMantMax = 1000
+	// engMinMantWidth is the minimum width of the mantissa of a number in
+	// engineering notation ("100" when shown to 1 or 2 significant figures)
+	engMinMantWidth = 3
+)
+
+// siPrefixes holds the SI prefixes in order of increasing exponent. The
+// exponent of the first entry is -24 and each subsequent entry is 3 greater
+var siPrefixes = []string{
+	"y", "z", "a", "f", "p", "n", "µ", "m",
+	"",
+	"k", "M", "G", "T", "P", "E", "Z", "Y",
+}
+
+// siNoPrefixIdx is the index of the (empty) prefix for an exponent of zero
+const siNoPrefixIdx = 8
+
+// check returns a non-nil error if the FloatStyle is invalid
+func (fs FloatStyle) check() error {
+	switch fs {
+	case FloatStd, FloatEng, FloatSI, FloatSigFigs:
+		return nil
+	}
+
+	return fmt.Errorf("bad Style: %d", fs)
+}
+
+// sigFigs returns the number of significant figures to be shown
+func (f Float) sigFigs() int {
+	if f.SigFigs < 1 {
+		return dfltSigFigs
+	}
+
+	return f.SigFigs
+}
+
+// engMantWidth returns the maximum width of the mantissa (without any sign)
+// when shown in engineering notation to the given number of significant
+// figures
+func engMantWidth(sigFigs int) int {
+	return max(engMinMantWidth, sigFigs+1)
+}
+
+// engParts returns the mantissa of the value formatted to the given number
+// of significant figures and the exponent (which is a multiple of 3). The
+// value must not be NaN or infinite.
+func engParts(f64 float64, sigFigs int) (string, int) {
+	if f64 == 0 {
+		return strconv.FormatFloat(f64, 'f', sigFigs-1, 64), 0
+	}
+
+	exp := int(math.Floor(math.Log10(math.Abs(f64))))
+
+	for {
+		exp3 := int(math.Floor(float64(exp)/engExpStep)) * engExpStep
+		intDigits := exp - exp3 + 1
+
+		mant := f64 / math.Pow10(exp3)
+		if sigFigs < intDigits {
+			scale := math.Pow10(intDigits - sigFigs)
+			mant = math.Round(mant/scale) * scale
+		}
+
+		s := strconv.FormatFloat(mant, 'f', max(sigFigs-intDigits, 0), 64)
+
+		// rounding may have taken the mantissa up to the next exponent
+		if m, err := strconv.ParseFloat(s, 64); err == nil &&
+			math.Abs(m) >= engMantMax {
+			exp = exp3 + engExpStep
+			continue
+		}
+
+		return s, exp3
+	}
+}
+
+// engFormatted returns the value formatted according to the FloatEng or
+// FloatSI styles
+func (f Float) engFormatted(f64 float64) string {
+	if math.IsNaN(f64) || math.IsInf(f64, 0) {
+		s := f.Sign.apply(
+			strings.TrimPrefix(strconv.FormatFloat(f64, 'f', -1, 64), "+")+
+				f.Unit,
+			false)
+
+		return fmt.Sprintf("%*s", f.numWidth()+f.Sign.extraWidth(), s)
+	}
+
+	mant, exp3 := engParts(f64, f.sigFigs())
+	suffix := fmt.Sprintf("e%+03d", exp3)
+
+	if f.Style == FloatSI {
+		if idx := siNoPrefixIdx + exp3/engExpStep; idx >= 0 &&
+			idx < len(siPrefixes) {
+			suffix = siPrefixes[idx]
+			if suffix == "" {
+				suffix = " "
+			}
+		}
+	}
+
+	s := f.Sign.apply(mant+suffix+f.Unit, f64 == 0)
+
+	return fmt.Sprintf("%*s", f.numWidth()+f.Sign.extraWidth(), s)
+}
+
+// engWidth returns the width needed for values shown in the FloatEng or
+// FloatSI styles (without any extra space for the sign). The FloatSI style
+// needs the same width as the FloatEng style as values too large or too
+// small to be shown with an SI prefix are shown in engineering notation.
+func (f Float) engWidth() int {
+	return 1 + engMantWidth(f.sigFigs()) + utf8.RuneCountInString(f.Unit) +
+		engExpWidth
+}
+
+// sigFigsWidth returns the width needed for values shown in the
+// FloatSigFigs style (without any extra space for the sign). This is
+// enough to show any value in exponent notation, with space for any
+// reserved decimal places.
+func (f Float) sigFigsWidth() int {
+	sigFigs := f.sigFigs()
+
+	w := 1 + sigFigs + engExpWidth
+	if sigFigs > 1 {
+		w++ // for the decimal point
+	}
+
+	return max(w, max(f.Prec, 0)+2) //nolint:mnd
+}
+
+// alignPoint pads the string with trailing spaces so that its decimal
+// point (or where the decimal point would be, if it has none) is followed
+// by Prec characters. If there is no room the string is returned unchanged
+func (f Float) alignPoint(s string) string {
+	if f.Prec <= 0 {
+		return s
+	}
+
+	fracLen := 0
+	if idx := strings.Index(s, "."); idx >= 0 {
+		fracLen = len(s) - idx
+	}
+
+	if fracLen > f.Prec+1 {
+		return s
+	}
+
+	return s + strings.Repeat(" ", f.Prec+1-fracLen)
+}
+
+// sigFigsFormatted returns the value formatted according to the
+// FloatSigFigs style. Fixed notation is used if the value will fit in the
+// available width, otherwise exponent notation is used.
+func (f Float) sigFigsFormatted(f64 float64) string {
+	sigFigs := f.sigFigs()
+
+	s := strconv.FormatFloat(f64, 'e', sigFigs-1, 64)
+
+	if f64 != 0 && !math.IsNaN(f64) && !math.IsInf(f64, 0) {
+		rounded, _ := strconv.ParseFloat(s, 64)
+		exp := int(math.Floor(math.Log10(math.Abs(rounded))))
+
+		fixed := f.alignPoint(f.trimTrailingZeros(
+			strconv.FormatFloat(rounded, 'f', max(sigFigs-1-exp, 0), 64)))
+		if utf8.RuneCountInString(fixed) <= f.numWidth() {
+			s = fixed
+		} else {
+			s = f.alignPoint(s)
+		}
+	} else {
+		s = f.alignPoint(f.trimTrailingZeros(
+			strconv.FormatFloat(f64, 'f', sigFigs-1, 64)))
+	}
+
+	return f.Sign.apply(s, f64 == 0)
+}
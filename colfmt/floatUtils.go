@@ -3,23 +3,9 @@ package colfmt
 import (
 	"fmt"
 	"math"
+	"reflect"
 )
 
-// getValAsFloat64 converts the interface value into a float64 if
-// possible. It will set the boolean return value to false if it is not
-// possible
-func getValAsFloat64(v any) (float64, bool) {
-	if f64, ok := v.(float64); ok {
-		return f64, true
-	}
-
-	if f32, ok := v.(float32); ok {
-		return float64(f32), true
-	}
-
-	return 0.0, false
-}
-
 // calcEpsilon calculates the appropriate epsilon value for the given precision
 func calcEpsilon(prec int) float64 {
 	if prec < 0 {
@@ -32,3 +18,55 @@ func calcEpsilon(prec int) float64 {
 
 	return 5.0 * math.Pow10(scale) //nolint:mnd
 }
+
+// getNumAsFloat64 converts the interface value into a float64 if it is any
+// of the integer or float types, including named types such as
+// "type Money float64". It will set the boolean return value to false if it
+// is not possible
+//
+//nolint:cyclop
+func getNumAsFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case nil:
+		return 0.0, false
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	default:
+		return 0.0, false
+	}
+}
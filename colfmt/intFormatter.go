@@ -2,33 +2,56 @@ package colfmt
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/nickwells/col.mod/v6/col"
 )
 
 // Int records the values needed for the formatting of an int value.
 //
-// See [NilHdlr] and [DupHdlr] for the settings that can be given through
-// those types.
+// See [NilHdlr], [DupHdlr] and [ThresholdHdlr] for the settings that can be
+// given through those types.
 type Int struct {
 	// W gives the minimum space to be taken by the formatted value
 	W int
+	// Zeroes records any desired special handling for zero values
+	Zeroes *ZeroHandler
 	// HandleZeroes, if set to true will check if the value to be printed is
 	// zero and if so it will print the ZeroReplacement string instead. The
 	// string printed will not be wider than the minimum space given by the W
 	// value
+	//
+	// Deprecated: use Zeroes.
 	HandleZeroes bool
 	// ZeroReplacement is the value to be printed for zero if HandleZeroes is
 	// true
+	//
+	// Deprecated: use Zeroes.
 	ZeroReplacement string
 	// Verb specifies the formatting verb. If left unset it will use
-	// 'd'. There will be a panic if it is not one of 'bcdoOqxXU'
+	// 'd'. If it is not one of 'bcdoOqxXU' then the Formatted method will
+	// panic and the FormattedE method will return an error
 	Verb rune
+	// Sign gives the way that the sign of the value is shown. If it is not
+	// set then negative values have a leading minus sign. Any extra space
+	// needed to show the sign is added to the width
+	Sign SignStyle
 
 	format string
 
 	NilHdlr
 	DupHdlr
+	ThresholdHdlr
+}
+
+// checkVerb returns a non-nil error if the Verb is invalid
+func (f Int) checkVerb() error {
+	switch f.Verb {
+	case 0, 'b', 'c', 'd', 'o', 'O', 'q', 'x', 'X', 'U':
+		return nil
+	}
+
+	return fmt.Errorf("%T: bad Format verb: %q", f, f.Verb)
 }
 
 // makeFormat sets the format string to be used to format the value. It uses
@@ -41,7 +64,7 @@ func (f *Int) makeFormat() {
 		case 'b', 'c', 'd', 'o', 'O', 'q', 'x', 'X', 'U':
 			f.format = "%" + string(f.Verb)
 		default:
-			panic(fmt.Errorf("%T: bad Format verb: %q", f, f.Verb))
+			panic(f.checkVerb())
 		}
 	}
 }
@@ -78,29 +101,81 @@ func isZero(v any) bool {
 	}
 }
 
-// Formatted returns the value formatted as an int
+// isInt returns true if the value is of one of the integer types
+func isInt(v any) bool {
+	if v == nil {
+		return false
+	}
+
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// Formatted returns the value formatted as an int. It will panic if the
+// Verb is invalid.
 func (f *Int) Formatted(v any) string {
+	if err := f.checkVerb(); err != nil {
+		panic(err)
+	}
+
+	s, _ := f.FormattedE(v)
+
+	return s
+}
+
+// FormattedE returns the value formatted as an int. If the value is not an
+// integer or the Verb is invalid a non-nil error is returned together with
+// a description of the value.
+func (f *Int) FormattedE(v any) (string, error) {
 	if f.SkipNil(v) {
-		return ""
+		return f.NilReplacement, nil
 	}
 
 	if f.SkipDup(v) {
-		return ""
+		return "", nil
+	}
+
+	if str, ok := f.Threshold(v); ok {
+		return f.Sign.padUnsigned(str), nil
 	}
 
 	if f.HandleZeroes {
 		if isZero(v) {
-			return fmt.Sprintf("%.*s", f.Width(), f.ZeroReplacement)
+			return f.Sign.padUnsigned(
+				fmt.Sprintf("%.*s", f.numWidth(), f.ZeroReplacement)), nil
 		}
 	}
 
+	if ok, str := f.Zeroes.GetZeroStr(0, v); ok {
+		return f.Sign.padUnsigned(fmt.Sprintf("%.*s", f.numWidth(), str)), nil
+	}
+
+	if err := f.checkVerb(); err != nil {
+		return fmt.Sprintf("%"+string(f.Verb), v), err
+	}
+
 	f.makeFormat()
 
-	return fmt.Sprintf(f.format, v)
+	s := f.Sign.apply(fmt.Sprintf(f.format, v), isZero(v))
+
+	if !isInt(v) {
+		return s,
+			fmt.Errorf("%T: integer value expected (got: %T): %v", *f, v, v)
+	}
+
+	return s, nil
 }
 
-// Width returns the intended width of the value
-func (f Int) Width() int {
+// numWidth returns the intended width of the value without any extra space
+// needed for the sign
+func (f Int) numWidth() int {
 	if f.W == 0 {
 		return 1
 	}
@@ -108,18 +183,35 @@ func (f Int) Width() int {
 	return f.W
 }
 
+// Width returns the intended width of the value
+func (f Int) Width() int {
+	return max(f.numWidth(), f.thresholdWidth()) + f.Sign.extraWidth()
+}
+
 // Just returns the justification of the value
 func (f Int) Just() col.Justification {
 	return col.Right
 }
 
-// Check returns a non-nil error if the Verb is invalid
+// Check returns a non-nil error if the Verb or the Sign is invalid
 func (f Int) Check() error {
-	switch f.Verb {
-	case 0, 'b', 'c', 'd', 'o', 'O', 'q', 'x', 'X', 'U':
-	default:
-		return fmt.Errorf("%T: bad Format verb: %q", f, f.Verb)
+	if err := f.checkVerb(); err != nil {
+		return err
+	}
+
+	if err := f.Sign.check(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
+	if err := f.checkThresholds(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
 	}
 
 	return nil
 }
+
+// Reset clears the saved format and forgets any previous value
+func (f *Int) Reset() {
+	f.format = ""
+	f.DupHdlr.Reset()
+}
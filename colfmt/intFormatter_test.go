@@ -98,6 +98,61 @@ func TestIntFormatter(t *testing.T) {
 			val:    int64(0),
 			expStr: "",
 		},
+		{
+			ID:     testhelper.MkID("sign style: parens, negative"),
+			intF:   colfmt.Int{Sign: colfmt.SignParens},
+			val:    -12,
+			expStr: "(12)",
+		},
+		{
+			ID:     testhelper.MkID("sign style: parens, positive"),
+			intF:   colfmt.Int{Sign: colfmt.SignParens},
+			val:    12,
+			expStr: "12 ",
+		},
+		{
+			ID: testhelper.MkID("sign style: parens, zero replaced"),
+			intF: colfmt.Int{
+				Sign:            colfmt.SignParens,
+				HandleZeroes:    true,
+				ZeroReplacement: "-",
+			},
+			val:    0,
+			expStr: "- ",
+		},
+		{
+			ID: testhelper.MkID("zero handler, zero"),
+			intF: colfmt.Int{
+				W:      3,
+				Zeroes: &colfmt.ZeroHandler{Handle: true, Replace: "nil"},
+			},
+			val:    uint16(0),
+			expStr: "nil",
+		},
+		{
+			ID: testhelper.MkID("zero handler, epsilon override"),
+			intF: colfmt.Int{
+				Zeroes: &colfmt.ZeroHandler{
+					Handle:  true,
+					Replace: "few",
+					Epsilon: 10,
+				},
+			},
+			val:    int8(-9),
+			expStr: "f",
+		},
+		{
+			ID:     testhelper.MkID("sign style: always, positive"),
+			intF:   colfmt.Int{Sign: colfmt.SignAlways},
+			val:    12,
+			expStr: "+12",
+		},
+		{
+			ID:     testhelper.MkID("sign style: always, zero"),
+			intF:   colfmt.Int{Sign: colfmt.SignAlways},
+			val:    0,
+			expStr: "0",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -123,9 +178,37 @@ func TestIntWidth(t *testing.T) {
 			},
 			expWidth: 9,
 		},
+		{
+			ID: testhelper.MkID("width > 0, sign style: parens"),
+			intF: colfmt.Int{
+				W:    9,
+				Sign: colfmt.SignParens,
+			},
+			expWidth: 11,
+		},
 	}
 
 	for _, tc := range testCases {
 		testhelper.DiffInt(t, tc.IDStr(), "width", tc.intF.Width(), tc.expWidth)
 	}
 }
+
+func TestIntBadVerb(t *testing.T) {
+	f := colfmt.Int{Verb: 'f'}
+
+	s, err := f.FormattedE(3)
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("FormattedE, bad verb"),
+		ExpErr: testhelper.MkExpErr(`colfmt.Int: bad Format verb: 'f'`),
+	})
+	testhelper.DiffString(t, "FormattedE, bad verb", "formatted value",
+		s, "%!f(int=3)")
+
+	panicked, _ := testhelper.PanicSafe(func() { f.Formatted(3) })
+	if !panicked {
+		t.Error("Formatted should panic when the verb is bad")
+	}
+}
@@ -0,0 +1,89 @@
+package colfmt
+
+import (
+	"fmt"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// Link records the values needed for the formatting of a value as a
+// terminal hyperlink (using the OSC 8 escape sequence). The text shown is
+// generated from the value as for the Any Formatter and the URL is generated
+// by the URL function. Only the visible text is counted when the value is
+// padded to fit in the column and the Report will show just the text if it
+// is not writing to a terminal.
+//
+// See [NilHdlr] and [DupHdlr] for the settings that can be given through
+// those types.
+type Link struct {
+	// W gives the minimum width of the value that should be printed
+	W int
+	// MaxW gives the maximum width of the visible text, if it is set to zero
+	// then no limit is applied. If it is set to a negative value then the W
+	// value is used. If it is a positive value then that is used
+	MaxW int
+	// StrJust gives the justification to be used
+	StrJust col.Justification
+	// URL returns the URL to be linked to for the value. If it returns an
+	// empty string then the text is shown without a link. This must be set
+	URL func(any) string
+
+	NilHdlr
+	DupHdlr
+}
+
+// maxWidth returns the maximum width of the visible text or zero if there
+// is no limit
+func (f Link) maxWidth() int {
+	if f.MaxW < 0 {
+		return max(f.W, 0)
+	}
+
+	return f.MaxW
+}
+
+// Formatted returns the value formatted as a hyperlink
+func (f *Link) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	if f.SkipDup(v) {
+		return ""
+	}
+
+	text := anyAsString(v, 'v')
+	if maxW := f.maxWidth(); maxW > 0 {
+		text = truncWidth(text, maxW)
+	}
+
+	if f.URL == nil {
+		return text
+	}
+
+	url := f.URL(v)
+	if url == "" {
+		return text
+	}
+
+	return col.Hyperlink(url, text)
+}
+
+// Width returns the intended width of the value
+func (f Link) Width() int {
+	return f.W
+}
+
+// Just returns the justification of the value
+func (f Link) Just() col.Justification {
+	return f.StrJust
+}
+
+// Check returns a non-nil error if the URL function is not set
+func (f Link) Check() error {
+	if f.URL == nil {
+		return fmt.Errorf("%T: the URL function must be set", f)
+	}
+
+	return nil
+}
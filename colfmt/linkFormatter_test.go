@@ -0,0 +1,64 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestLinkFormatter(t *testing.T) {
+	issueURL := func(v any) string {
+		if s, ok := v.(string); ok && s != "" {
+			return "https://example.com/issues/" + s
+		}
+
+		return ""
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		lf     colfmt.Link
+		val    any
+		expStr string
+	}{
+		{
+			ID:  testhelper.MkID("link"),
+			lf:  colfmt.Link{URL: issueURL},
+			val: "42",
+			expStr: col.Hyperlink("https://example.com/issues/42",
+				"42"),
+		},
+		{
+			ID:     testhelper.MkID("no URL for the value"),
+			lf:     colfmt.Link{URL: issueURL},
+			val:    17,
+			expStr: "17",
+		},
+		{
+			ID:  testhelper.MkID("max width - only the text is truncated"),
+			lf:  colfmt.Link{MaxW: 3, URL: issueURL},
+			val: "12345",
+			expStr: col.Hyperlink("https://example.com/issues/12345",
+				"123"),
+		},
+		{
+			ID: testhelper.MkID("ignore nil, pass nil"),
+			lf: colfmt.Link{
+				URL:     issueURL,
+				NilHdlr: colfmt.NilHdlr{IgnoreNil: true},
+			},
+			expStr: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.lf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+
+	if err := (colfmt.Link{}).Check(); err == nil {
+		t.Errorf("a Link with no URL function should fail the Check")
+	}
+}
@@ -1,19 +1,44 @@
 package colfmt
 
-// NilHdlr encapsulates all the parts needed to support the suppression of the
-// printing of duplicate values
+import "reflect"
+
+// NilHdlr encapsulates all the parts needed to support the replacement of
+// nil values
 type NilHdlr struct {
-	// IgnoreNil, if set to true will make nil values print as the empty
-	// string (or some other value chosen by the formatter using this).
+	// IgnoreNil, if set to true will make nil values print as the
+	// NilReplacement (by default, the empty string).
 	IgnoreNil bool
+	// NilReplacement is the value to be printed for nil values. For
+	// instance, "-" or "n/a". Setting this to a non-empty value has the
+	// same effect as setting IgnoreNil. Note that the width of the column
+	// is not adjusted to make room for this value.
+	NilReplacement string
+}
+
+// isNil returns true if the value is nil. This includes typed nil values,
+// such as a nil pointer, slice or map, stored in the interface.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map,
+		reflect.Func, reflect.Chan, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
 }
 
-// SkipNil returns true if the value, v, is nil and the IgnoreNil flag is
-// set.
+// SkipNil returns true if the value, v, is nil and either the IgnoreNil flag
+// is set or there is a NilReplacement. Typed nil values, such as a nil
+// pointer, slice or map, are treated as nil.
 //
 // This should be called by the column formatter's Formatted method to decide
-// whether or not to replace the value with a new value (typically the empty
-// string)
+// whether or not to replace the value with the NilReplacement.
 func (nh *NilHdlr) SkipNil(v any) bool {
-	return nh.IgnoreNil && v == nil
+	return (nh.IgnoreNil || nh.NilReplacement != "") && isNil(v)
 }
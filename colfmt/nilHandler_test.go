@@ -0,0 +1,88 @@
+package colfmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestNilHdlr(t *testing.T) {
+	var (
+		nilPtr   *int
+		nilSlice []int
+		nilMap   map[string]int
+		nilTime  *time.Time
+		i        = 1
+	)
+
+	testCases := []struct {
+		testhelper.ID
+		nh      colfmt.NilHdlr
+		val     any
+		expSkip bool
+	}{
+		{
+			ID:  testhelper.MkID("not ignoring nil"),
+			val: nil,
+		},
+		{
+			ID:      testhelper.MkID("nil"),
+			nh:      colfmt.NilHdlr{IgnoreNil: true},
+			val:     nil,
+			expSkip: true,
+		},
+		{
+			ID:      testhelper.MkID("nil pointer"),
+			nh:      colfmt.NilHdlr{IgnoreNil: true},
+			val:     nilPtr,
+			expSkip: true,
+		},
+		{
+			ID:      testhelper.MkID("nil slice"),
+			nh:      colfmt.NilHdlr{IgnoreNil: true},
+			val:     nilSlice,
+			expSkip: true,
+		},
+		{
+			ID:      testhelper.MkID("nil map, replacement"),
+			nh:      colfmt.NilHdlr{NilReplacement: "-"},
+			val:     nilMap,
+			expSkip: true,
+		},
+		{
+			ID:  testhelper.MkID("non-nil pointer"),
+			nh:  colfmt.NilHdlr{IgnoreNil: true},
+			val: &i,
+		},
+		{
+			ID:  testhelper.MkID("empty slice"),
+			nh:  colfmt.NilHdlr{IgnoreNil: true},
+			val: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		if skip := tc.nh.SkipNil(tc.val); skip != tc.expSkip {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: expected SkipNil: %t, got: %t", tc.expSkip, skip)
+		}
+	}
+
+	nh := colfmt.NilHdlr{NilReplacement: "n/a"}
+
+	for _, f := range []col.Formatter{
+		&colfmt.String{NilHdlr: nh},
+		&colfmt.Int{NilHdlr: nh},
+		&colfmt.Float{NilHdlr: nh},
+		&colfmt.Percent{NilHdlr: nh},
+		&colfmt.Time{NilHdlr: nh},
+		&colfmt.Bool{NilHdlr: nh},
+	} {
+		testhelper.DiffString(t, "nil replacement", fmt.Sprintf("%T", f),
+			f.Formatted(nilTime), "n/a")
+	}
+}
@@ -11,32 +11,57 @@ import (
 // percentage value. The value is expected to be a proportion and so is
 // multiplied by 100 to convert it into a percentage value and then a % sign
 // is added to the end (unless SuppressPct is set to true)
+//
+// See [NilHdlr] and [ThresholdHdlr] for the settings that can be given
+// through those types.
 type Percent struct {
 	// W gives the minimum space to be taken by the formatted value
 	W int
 	// Prec gives the precision with which to print the value when formatted
 	Prec int
-	// IgnoreNil, if set to true will make nil values print as the empty string
+	// IgnoreNil, if set to true will make nil values print as the empty
+	// string. It is retained for backwards compatibility and has the same
+	// effect as setting the NilHdlr's IgnoreNil field
 	IgnoreNil bool
 	// SuppressPct, if set to true will cause the '%' sign not to be printed
 	SuppressPct bool
 	// Zeroes records any desired special handling for zero values
-	Zeroes *FloatZeroHandler
+	Zeroes *ZeroHandler
+	// Sign gives the way that the sign of the value is shown. If it is not
+	// set then negative values have a leading minus sign. Any extra space
+	// needed to show the sign is added to the width
+	Sign SignStyle
+
+	NilHdlr
+	ThresholdHdlr
 }
 
 // Formatted returns the value formatted as a percentage. That is it is taken
 // to be a proportion and is converted into a percentage value. So passing it
 // a value of 1.25 will return a value of 125% (or 125 depending on the
 // setting of SuppressPct)
+func (f *Percent) Formatted(v any) string {
+	s, _ := f.FormattedE(v)
+	return s
+}
+
+// FormattedE returns the value formatted as a percentage, as for the
+// Formatted method. A nil value (including a typed nil, such as a nil
+// pointer) which is not ignored or replaced is shown as "nil". If the value is not numeric a non-nil error is returned
+// together with a description of the value.
 //
 //nolint:cyclop
-func (f *Percent) Formatted(v any) string {
-	if v == nil {
-		if f.IgnoreNil {
-			return ""
-		}
+func (f *Percent) FormattedE(v any) (string, error) {
+	if f.SkipNil(v) || (f.IgnoreNil && isNil(v)) {
+		return f.NilReplacement, nil
+	}
 
-		return "nil"
+	if isNil(v) {
+		return "nil", nil
+	}
+
+	if str, ok := f.Threshold(v); ok {
+		return f.Sign.padUnsigned(str), nil
 	}
 
 	pctSign := "%%"
@@ -72,19 +97,37 @@ func (f *Percent) Formatted(v any) string {
 	case uint:
 		pct = mathutil.ToPercent(float64(flt))
 	default:
-		return fmt.Sprintf("Numeric value expected (got: %T): %v", v, v)
+		f64, ok := getNumAsFloat64(v)
+		if !ok {
+			return fmt.Sprintf("Numeric value expected (got: %T): %v", v, v),
+				fmt.Errorf("%T: numeric value expected (got: %T): %v",
+					*f, v, v)
+		}
+
+		pct = mathutil.ToPercent(f64)
 	}
 
 	if ok, str := f.Zeroes.GetZeroStr(f.Prec, pct); ok {
-		return fmt.Sprintf("%.*s", f.Width(), str)
+		return f.Sign.padUnsigned(fmt.Sprintf("%.*s", f.numWidth(), str)), nil
+	}
+
+	if f.Zeroes.isNegZero(f.Prec, pct) {
+		pct = 0
 	}
 
-	return fmt.Sprintf("%.*f"+pctSign, f.Prec, pct)
+	return f.Sign.apply(fmt.Sprintf("%.*f"+pctSign, f.Prec, pct), pct == 0),
+		nil
 }
 
 // Width returns the intended width of the value. An invalid width or one
 // incompatible with the given precision is ignored
 func (f Percent) Width() int {
+	return max(f.numWidth(), f.thresholdWidth()) + f.Sign.extraWidth()
+}
+
+// numWidth returns the intended width of the value without any extra space
+// needed for the sign
+func (f Percent) numWidth() int {
 	minWidth := 1
 	if !f.SuppressPct {
 		minWidth++ // for the % sign
@@ -107,7 +150,15 @@ func (f Percent) Just() col.Justification {
 	return col.Right
 }
 
-// Check returns a nil error
+// Check returns a non-nil error if the Sign is invalid
 func (f Percent) Check() error {
+	if err := f.Sign.check(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
+	if err := f.checkThresholds(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package colfmt_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/nickwells/col.mod/v6/colfmt"
@@ -23,11 +24,28 @@ func TestPctFormatter(t *testing.T) {
 			ID:     testhelper.MkID("basic, pass nil"),
 			expStr: "nil",
 		},
+		{
+			ID:     testhelper.MkID("basic, pass typed nil"),
+			val:    (*float64)(nil),
+			expStr: "nil",
+		},
+		{
+			ID: testhelper.MkID("nil replacement, pass nil"),
+			pf: colfmt.Percent{
+				NilHdlr: colfmt.NilHdlr{NilReplacement: "n/a"},
+			},
+			expStr: "n/a",
+		},
 		{
 			ID:     testhelper.MkID("basic, pass float64"),
 			val:    float64(0.123),
 			expStr: "12%",
 		},
+		{
+			ID:     testhelper.MkID("named float type"),
+			val:    money(0.25),
+			expStr: "25%",
+		},
 		{
 			ID:     testhelper.MkID("basic, pass float32"),
 			val:    float32(0.123),
@@ -54,6 +72,11 @@ func TestPctFormatter(t *testing.T) {
 			pf:     colfmt.Percent{IgnoreNil: true},
 			expStr: "",
 		},
+		{
+			ID:     testhelper.MkID("NilHdlr ignore nil, pass nil"),
+			pf:     colfmt.Percent{NilHdlr: colfmt.NilHdlr{IgnoreNil: true}},
+			expStr: "",
+		},
 		{
 			ID:     testhelper.MkID("with precision"),
 			pf:     colfmt.Percent{Prec: 2},
@@ -157,6 +180,18 @@ func TestPctFormatter(t *testing.T) {
 			val:    0.123,
 			expStr: "12",
 		},
+		{
+			ID:     testhelper.MkID("sign style: parens, negative"),
+			pf:     colfmt.Percent{Prec: 1, Sign: colfmt.SignParens},
+			val:    -0.125,
+			expStr: "(12.5%)",
+		},
+		{
+			ID:     testhelper.MkID("sign style: trailing, negative"),
+			pf:     colfmt.Percent{Prec: 1, Sign: colfmt.SignTrailing},
+			val:    -0.125,
+			expStr: "12.5%-",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -165,6 +200,20 @@ func TestPctFormatter(t *testing.T) {
 	}
 }
 
+func TestPercentNilNoErr(t *testing.T) {
+	pf := colfmt.Percent{}
+
+	for _, v := range []any{nil, (*float64)(nil), []int(nil)} {
+		s, err := pf.FormattedE(v)
+		if err != nil {
+			t.Errorf("unexpected error formatting %#v: %s", v, err)
+		}
+
+		testhelper.DiffString(t, fmt.Sprintf("%#v", v), "formatted value",
+			s, "nil")
+	}
+}
+
 func TestPercentWidth(t *testing.T) {
 	testCases := []struct {
 		testhelper.ID
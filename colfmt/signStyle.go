@@ -0,0 +1,99 @@
+package colfmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SignStyle describes how the sign of a number is shown
+type SignStyle int
+
+// The SignStyle values:
+//
+//	SignLeading means negative numbers have a leading minus sign: -1.23
+//	SignTrailing means negative numbers have a trailing minus sign: 1.23-
+//	SignParens means negative numbers are shown in parentheses: (1.23)
+//	SignAlways means non-zero numbers always have a leading sign: +1.23
+//
+// For the SignTrailing and SignParens styles positive numbers are followed
+// by a space so that their digits line up with those of negative numbers.
+const (
+	SignLeading SignStyle = iota
+	SignTrailing
+	SignParens
+	SignAlways
+)
+
+// extraWidth returns the extra width needed to show the sign
+func (ss SignStyle) extraWidth() int {
+	switch ss {
+	case SignTrailing, SignAlways:
+		return 1
+	case SignParens:
+		return 2 //nolint:mnd
+	}
+
+	return 0
+}
+
+// padUnsigned returns the string with any space needed to line it up with
+// signed values. It should be used for values which are shown without any
+// sign such as zero replacement strings.
+func (ss SignStyle) padUnsigned(s string) string {
+	switch ss {
+	case SignTrailing, SignParens:
+		return s + " "
+	}
+
+	return s
+}
+
+// apply takes the formatted number, s, and returns it with the sign
+// presented according to the SignStyle. The isZero flag should be set if
+// the value is exactly zero in which case no '+' sign is added. Any trailing
+// spaces (such as those left by trimming trailing zeroes) are kept at the
+// end of the string.
+func (ss SignStyle) apply(s string, isZero bool) string {
+	if ss == SignLeading {
+		return s
+	}
+
+	body := strings.TrimRight(s, " ")
+	pad := s[len(body):]
+
+	neg := strings.HasPrefix(body, "-")
+	body = strings.TrimPrefix(body, "-")
+
+	switch ss {
+	case SignTrailing:
+		if neg {
+			return body + "-" + pad
+		}
+	case SignParens:
+		if neg {
+			return "(" + body + ")" + pad
+		}
+	case SignAlways:
+		if neg {
+			return "-" + body + pad
+		}
+
+		if !isZero {
+			return "+" + body + pad
+		}
+
+		return body + pad
+	}
+
+	return ss.padUnsigned(body) + pad
+}
+
+// check returns a non-nil error if the SignStyle is invalid
+func (ss SignStyle) check() error {
+	switch ss {
+	case SignLeading, SignTrailing, SignParens, SignAlways:
+		return nil
+	}
+
+	return fmt.Errorf("bad SignStyle: %d", ss)
+}
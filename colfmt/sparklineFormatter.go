@@ -0,0 +1,141 @@
+package colfmt
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// DfltSparklineW is the number of values shown by a Sparkline if its W is
+// not set
+const DfltSparklineW = 10
+
+// sparkLevels holds the characters used to show the relative size of each
+// value in a Sparkline, from the smallest to the largest
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline records the values needed for the formatting of a slice of
+// numbers (for instance, a []float64 or a []int) as a small chart. Each
+// value in the slice is shown as a single character whose height gives the
+// relative size of the value.
+//
+// See [NilHdlr] for the settings that can be given through that type.
+type Sparkline struct {
+	// W gives the maximum number of values to be shown. If there are more
+	// values than this in the slice then only the last W values are
+	// shown. If it is less than 1 then DfltSparklineW is used
+	W int
+	// Min and Max give the range of values to be shown; any values outside
+	// this range are shown as the lowest or highest level as
+	// appropriate. If they are both zero then the range is taken from the
+	// smallest and largest finite values in each slice
+	Min, Max float64
+
+	NilHdlr
+}
+
+// getVals converts the value into a slice of float64's. It returns false if
+// the value is not a slice or array of numbers
+func (f Sparkline) getVals(v any) ([]float64, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	vals := make([]float64, 0, rv.Len())
+
+	for i := range rv.Len() {
+		f64, ok := getNumAsFloat64(rv.Index(i).Interface())
+		if !ok {
+			return nil, false
+		}
+
+		vals = append(vals, f64)
+	}
+
+	if w := f.Width(); len(vals) > w {
+		vals = vals[len(vals)-w:]
+	}
+
+	return vals, true
+}
+
+// getRange returns the range of values to be shown
+func (f Sparkline) getRange(vals []float64) (float64, float64) {
+	if f.Min != 0 || f.Max != 0 {
+		return f.Min, f.Max
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+
+	for _, f64 := range vals {
+		if math.IsNaN(f64) || math.IsInf(f64, 0) {
+			continue
+		}
+
+		lo = min(lo, f64)
+		hi = max(hi, f64)
+	}
+
+	return lo, hi
+}
+
+// Formatted returns the value formatted as a sparkline
+func (f *Sparkline) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	vals, ok := f.getVals(v)
+	if !ok {
+		return fmt.Sprintf("Numeric slice expected (got: %T): %v", v, v)
+	}
+
+	lo, hi := f.getRange(vals)
+	topLevel := float64(len(sparkLevels) - 1)
+
+	var b strings.Builder
+
+	for _, f64 := range vals {
+		switch {
+		case math.IsNaN(f64):
+			b.WriteRune(' ')
+		case hi <= lo || f64 <= lo:
+			b.WriteRune(sparkLevels[0])
+		case f64 >= hi:
+			b.WriteRune(sparkLevels[len(sparkLevels)-1])
+		default:
+			b.WriteRune(sparkLevels[int(math.Round(
+				topLevel*(f64-lo)/(hi-lo)))])
+		}
+	}
+
+	return b.String()
+}
+
+// Width returns the intended width of the value
+func (f Sparkline) Width() int {
+	if f.W <= 0 {
+		return DfltSparklineW
+	}
+
+	return f.W
+}
+
+// Just returns the justification of the value
+func (f Sparkline) Just() col.Justification {
+	return col.Left
+}
+
+// Check returns a non-nil error if the Min and Max values are inconsistent
+func (f Sparkline) Check() error {
+	if f.Min > f.Max {
+		return fmt.Errorf("%T: the Min value (%g) is greater than the Max (%g)",
+			f, f.Min, f.Max)
+	}
+
+	return nil
+}
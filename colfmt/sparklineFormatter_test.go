@@ -0,0 +1,144 @@
+package colfmt_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSparklineFormatter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		sf     colfmt.Sparkline
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("floats"),
+			val:    []float64{0, 1, 2, 3, 4, 5, 6, 7},
+			expStr: "▁▂▃▄▅▆▇█",
+		},
+		{
+			ID:     testhelper.MkID("ints"),
+			val:    []int{0, 7, 0},
+			expStr: "▁█▁",
+		},
+		{
+			ID:     testhelper.MkID("array"),
+			val:    [3]uint8{0, 7, 14},
+			expStr: "▁▅█",
+		},
+		{
+			ID:     testhelper.MkID("ints, limited width"),
+			sf:     colfmt.Sparkline{W: 3},
+			val:    []int{7, 0, 7, 0},
+			expStr: "▁█▁",
+		},
+		{
+			ID:     testhelper.MkID("default width"),
+			val:    []int{7, 7, 0, 1, 2, 3, 4, 5, 6, 7, 7, 7},
+			expStr: "▁▂▃▄▅▆▇███",
+		},
+		{
+			ID:     testhelper.MkID("fixed range"),
+			sf:     colfmt.Sparkline{Min: 0, Max: 14},
+			val:    []int{-1, 7, 20},
+			expStr: "▁▅█",
+		},
+		{
+			ID:     testhelper.MkID("fixed range, negative"),
+			sf:     colfmt.Sparkline{Min: -7, Max: 0},
+			val:    []float64{-7, -3.5, 0},
+			expStr: "▁▅█",
+		},
+		{
+			ID:     testhelper.MkID("all the same"),
+			val:    []int{3, 3},
+			expStr: "▁▁",
+		},
+		{
+			ID:     testhelper.MkID("NaN"),
+			val:    []float64{0, math.NaN(), 7},
+			expStr: "▁ █",
+		},
+		{
+			ID:     testhelper.MkID("infinities"),
+			val:    []float64{math.Inf(-1), 0, 7, math.Inf(1)},
+			expStr: "▁▁██",
+		},
+		{
+			ID:     testhelper.MkID("empty"),
+			val:    []float64{},
+			expStr: "",
+		},
+		{
+			ID:     testhelper.MkID("not a slice"),
+			val:    3,
+			expStr: "Numeric slice expected (got: int): 3",
+		},
+		{
+			ID:     testhelper.MkID("not a numeric slice"),
+			val:    []string{"a"},
+			expStr: "Numeric slice expected (got: []string): [a]",
+		},
+		{
+			ID:     testhelper.MkID("nil"),
+			expStr: "Numeric slice expected (got: <nil>): <nil>",
+		},
+		{
+			ID: testhelper.MkID("ignore nil, pass nil"),
+			sf: colfmt.Sparkline{
+				NilHdlr: colfmt.NilHdlr{IgnoreNil: true},
+			},
+			expStr: "",
+		},
+		{
+			ID: testhelper.MkID("ignore nil, pass nil slice"),
+			sf: colfmt.Sparkline{
+				NilHdlr: colfmt.NilHdlr{
+					IgnoreNil:      true,
+					NilReplacement: "-",
+				},
+			},
+			val:    []float64(nil),
+			expStr: "-",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.sf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
+
+func TestSparklineWidth(t *testing.T) {
+	testhelper.DiffInt(t, "W not set", "width",
+		colfmt.Sparkline{}.Width(), colfmt.DfltSparklineW)
+	testhelper.DiffInt(t, "W set", "width",
+		colfmt.Sparkline{W: 5}.Width(), 5)
+}
+
+func TestSparklineCheck(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		sf colfmt.Sparkline
+	}{
+		{
+			ID: testhelper.MkID("good"),
+			sf: colfmt.Sparkline{Min: 1, Max: 2},
+		},
+		{
+			ID: testhelper.MkID("bad, Min > Max"),
+			ExpErr: testhelper.MkExpErr(
+				"the Min value (2) is greater than the Max (1)"),
+			sf: colfmt.Sparkline{Min: 2, Max: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, tc.sf.Check(), tc)
+	}
+}
@@ -25,44 +25,63 @@ type String struct {
 	// if the DupIndicator is too long to fit in the column it will be
 	// truncated according to the settings of the W and MaxW values.
 	DupIndicator string
-
-	format string
+	// Trunc gives the way in which values wider than the maximum width are
+	// truncated. If it is not set then the end of the value is simply cut
+	// off. The width is measured in terminal columns so that, for
+	// instance, East Asian wide characters are counted as two columns.
+	Trunc TruncMode
+	// TruncMarker gives the marker used to show where a value has been
+	// truncated. If it is not set then the DfltTruncMarker ("…") is used
+	// or, if ASCIIMarker is set, the ASCIITruncMarker ("...")
+	TruncMarker string
+	// ASCIIMarker, if set to true, will make the default TruncMarker be the
+	// ASCIITruncMarker
+	ASCIIMarker bool
 
 	NilHdlr
 	DupHdlr
 }
 
-// makeFormat sets the format string to be used to format the value.
-func (f *String) makeFormat() {
-	if f.format == "" {
-		switch {
-		case f.MaxW == 0:
-			f.format = "%s"
-		case f.MaxW < 0:
-			if f.W <= 0 {
-				f.format = "%s"
-			} else {
-				f.format = fmt.Sprintf("%%.%ds", f.W)
-			}
-		default:
-			f.format = fmt.Sprintf("%%.%ds", f.MaxW)
-		}
+// maxWidth returns the maximum width of the formatted value or zero if
+// there is no limit
+func (f String) maxWidth() int {
+	if f.MaxW < 0 {
+		return max(f.W, 0)
+	}
+
+	return f.MaxW
+}
+
+// truncMarker returns the marker to be used to show where a value has been
+// truncated
+func (f String) truncMarker() string {
+	switch {
+	case f.TruncMarker != "":
+		return f.TruncMarker
+	case f.ASCIIMarker:
+		return ASCIITruncMarker
 	}
+
+	return DfltTruncMarker
 }
 
 // Formatted returns the value formatted as a string
 func (f *String) Formatted(v any) string {
 	if f.SkipNil(v) {
-		return ""
+		return f.NilReplacement
 	}
 
 	if f.SkipDup(v) {
 		v = f.DupIndicator
 	}
 
-	f.makeFormat()
+	s := fmt.Sprintf("%s", v)
+
+	if maxW := f.maxWidth(); maxW > 0 {
+		return f.Trunc.truncate(s, maxW, f.truncMarker())
+	}
 
-	return fmt.Sprintf(f.format, v)
+	return s
 }
 
 // Width returns the intended width of the value
@@ -75,7 +94,11 @@ func (f String) Just() col.Justification {
 	return f.StrJust
 }
 
-// Check returns a nil error
+// Check returns a non-nil error if the Trunc value is invalid
 func (f String) Check() error {
+	if err := f.Trunc.check(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
 	return nil
 }
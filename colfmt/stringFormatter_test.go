@@ -0,0 +1,147 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestStringFormatter(t *testing.T) {
+	const path = "/usr/local/lib/go/src/file.go"
+
+	testCases := []struct {
+		testhelper.ID
+		sf     colfmt.String
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("basic"),
+			val:    "verylongname",
+			expStr: "verylongname",
+		},
+		{
+			ID:     testhelper.MkID("max width, cut"),
+			sf:     colfmt.String{MaxW: 8},
+			val:    "verylongname",
+			expStr: "verylong",
+		},
+		{
+			ID:     testhelper.MkID("max width, end ellipsis"),
+			sf:     colfmt.String{MaxW: 8, Trunc: colfmt.TruncEnd},
+			val:    "verylongname",
+			expStr: "verylon…",
+		},
+		{
+			ID: testhelper.MkID("max width from W, end ellipsis, ASCII"),
+			sf: colfmt.String{
+				W:           8,
+				MaxW:        -1,
+				Trunc:       colfmt.TruncEnd,
+				ASCIIMarker: true,
+			},
+			val:    "verylongname",
+			expStr: "veryl...",
+		},
+		{
+			ID:     testhelper.MkID("max width, start ellipsis"),
+			sf:     colfmt.String{MaxW: 8, Trunc: colfmt.TruncStart},
+			val:    "verylongname",
+			expStr: "…ongname",
+		},
+		{
+			ID:     testhelper.MkID("max width, middle ellipsis"),
+			sf:     colfmt.String{MaxW: 8, Trunc: colfmt.TruncMiddle},
+			val:    "verylongname",
+			expStr: "very…ame",
+		},
+		{
+			ID: testhelper.MkID("max width, middle, bespoke marker"),
+			sf: colfmt.String{
+				MaxW:        8,
+				Trunc:       colfmt.TruncMiddle,
+				TruncMarker: "~~",
+			},
+			val:    "verylongname",
+			expStr: "ver~~ame",
+		},
+		{
+			ID:     testhelper.MkID("max width, multi-byte runes"),
+			sf:     colfmt.String{MaxW: 4, Trunc: colfmt.TruncEnd},
+			val:    "ééééé",
+			expStr: "ééé…",
+		},
+		{
+			ID:     testhelper.MkID("max width, wide runes, cut"),
+			sf:     colfmt.String{MaxW: 4},
+			val:    "日本語テキスト",
+			expStr: "日本",
+		},
+		{
+			ID:     testhelper.MkID("max width, wide runes, cut mid-rune"),
+			sf:     colfmt.String{MaxW: 5},
+			val:    "日本語テキスト",
+			expStr: "日本",
+		},
+		{
+			ID:     testhelper.MkID("W as max width, wide runes, cut"),
+			sf:     colfmt.String{W: 3, MaxW: -1},
+			val:    "日本語テキスト",
+			expStr: "日",
+		},
+		{
+			ID:     testhelper.MkID("max width, wide runes, end"),
+			sf:     colfmt.String{MaxW: 4, Trunc: colfmt.TruncEnd},
+			val:    "日本語テキスト",
+			expStr: "日…",
+		},
+		{
+			ID:     testhelper.MkID("max width, wide runes, start"),
+			sf:     colfmt.String{MaxW: 5, Trunc: colfmt.TruncStart},
+			val:    "日本語テキスト",
+			expStr: "…スト",
+		},
+		{
+			ID:     testhelper.MkID("max width, wide runes, middle"),
+			sf:     colfmt.String{MaxW: 6, Trunc: colfmt.TruncMiddle},
+			val:    "日本語テキスト",
+			expStr: "日…ト",
+		},
+		{
+			ID:     testhelper.MkID("max width, combining marks"),
+			sf:     colfmt.String{MaxW: 3, Trunc: colfmt.TruncEnd},
+			val:    "e\u0301e\u0301e\u0301",
+			expStr: "e\u0301e\u0301e\u0301",
+		},
+		{
+			ID:     testhelper.MkID("path"),
+			sf:     colfmt.String{MaxW: 14, Trunc: colfmt.TruncPath},
+			val:    path,
+			expStr: "/usr/…/file.go",
+		},
+		{
+			ID:     testhelper.MkID("path, basename too long"),
+			sf:     colfmt.String{MaxW: 6, Trunc: colfmt.TruncPath},
+			val:    path,
+			expStr: "…le.go",
+		},
+		{
+			ID:     testhelper.MkID("path, no directory"),
+			sf:     colfmt.String{MaxW: 6, Trunc: colfmt.TruncPath},
+			val:    "filename.go",
+			expStr: "…me.go",
+		},
+		{
+			ID:     testhelper.MkID("short enough"),
+			sf:     colfmt.String{MaxW: 20, Trunc: colfmt.TruncPath},
+			val:    "file.go",
+			expStr: "file.go",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.sf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
@@ -0,0 +1,232 @@
+package colfmt
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// SwitchCase holds a Formatter and the conditions under which it should be
+// used by the Switch Formatter. If both the Type and the Pred are given then
+// the value must satisfy both; if neither is given then every value will
+// match.
+type SwitchCase struct {
+	// Type, if not nil, gives the type that the value must have for this
+	// case to be used. If it is an interface type, such as error, then
+	// the value must implement it; otherwise the value must be assignable
+	// to it. A nil value never matches a Type
+	Type reflect.Type
+	// Pred, if not nil, must return true for the value for this case to be
+	// used
+	Pred func(any) bool
+	// F is the Formatter to use for values matching this case. It must be
+	// set
+	F col.Formatter
+}
+
+// typeMatches returns true if the case has no Type or if the value is of
+// that Type (see the Type field)
+func (sc SwitchCase) typeMatches(v any) bool {
+	if sc.Type == nil {
+		return true
+	}
+
+	vt := reflect.TypeOf(v)
+	if vt == nil {
+		return false
+	}
+
+	if sc.Type.Kind() == reflect.Interface {
+		return vt.Implements(sc.Type)
+	}
+
+	return vt.AssignableTo(sc.Type)
+}
+
+// matches returns true if the value satisfies the conditions of the case
+func (sc SwitchCase) matches(v any) bool {
+	if !sc.typeMatches(v) {
+		return false
+	}
+
+	if sc.Pred != nil && !sc.Pred(v) {
+		return false
+	}
+
+	return true
+}
+
+// Switch records the values needed for the formatting of a column which can
+// hold values of different types. Each value is formatted using the
+// Formatter of the first of the Cases that it matches or else by the
+// Default Formatter. If there is no Default then the value is shown as for
+// the Any Formatter.
+//
+// All the Formatters must have the same justification.
+type Switch struct {
+	// Cases holds the Formatters and the conditions under which they should
+	// be used. They are tried in order
+	Cases []SwitchCase
+	// Default gives the Formatter to be used if none of the Cases match
+	Default col.Formatter
+}
+
+// formatters returns all the Formatters used by the Switch
+func (f Switch) formatters() []col.Formatter {
+	fs := make([]col.Formatter, 0, len(f.Cases)+1)
+
+	for _, sc := range f.Cases {
+		fs = append(fs, sc.F)
+	}
+
+	if f.Default != nil {
+		fs = append(fs, f.Default)
+	}
+
+	return fs
+}
+
+// formatterFor returns the Formatter to be used for the value. It returns
+// nil if none of the Cases match and there is no Default.
+func (f Switch) formatterFor(v any) col.Formatter {
+	for _, sc := range f.Cases {
+		if sc.matches(v) {
+			return sc.F
+		}
+	}
+
+	return f.Default
+}
+
+// Formatted returns the value formatted by the first matching Formatter
+func (f *Switch) Formatted(v any) string {
+	s, _ := f.FormattedE(v)
+	return s
+}
+
+// FormattedE returns the value formatted by the first matching Formatter,
+// as for the Formatted method. If that Formatter implements the
+// col.FormatterE interface then any error it returns is passed on.
+func (f *Switch) FormattedE(v any) (string, error) {
+	cf := f.formatterFor(v)
+	if cf == nil {
+		return anyAsString(v, 'v'), nil
+	}
+
+	if fe, ok := cf.(col.FormatterE); ok {
+		return fe.FormattedE(v)
+	}
+
+	return cf.Formatted(v), nil
+}
+
+// Width returns the intended width of the value. This is the greatest width
+// of any of the Formatters
+func (f Switch) Width() int {
+	w := 0
+
+	for _, cf := range f.formatters() {
+		if cf != nil {
+			w = max(w, cf.Width())
+		}
+	}
+
+	return w
+}
+
+// Just returns the justification of the value. This is the justification of
+// the first of the Formatters.
+func (f Switch) Just() col.Justification {
+	for _, cf := range f.formatters() {
+		if cf != nil {
+			return cf.Just()
+		}
+	}
+
+	return col.Left
+}
+
+// Check returns a non-nil error if there are no Formatters, if any of the
+// Formatters is missing or fails its own check or if the Formatters do not
+// all have the same justification
+func (f Switch) Check() error {
+	if len(f.Cases) == 0 && f.Default == nil {
+		return fmt.Errorf("%T: no Cases or Default have been given", f)
+	}
+
+	var errs []error
+
+	for i, sc := range f.Cases {
+		if sc.F == nil {
+			errs = append(errs, fmt.Errorf("%T: Cases[%d] has no Formatter",
+				f, i))
+
+			continue
+		}
+
+		if err := sc.F.Check(); err != nil {
+			errs = append(errs, fmt.Errorf("%T: Cases[%d]: %w", f, i, err))
+		}
+
+		if sc.F.Just() != f.Just() {
+			errs = append(errs,
+				fmt.Errorf("%T: Cases[%d] has inconsistent justification",
+					f, i))
+		}
+	}
+
+	if f.Default != nil {
+		if err := f.Default.Check(); err != nil {
+			errs = append(errs, fmt.Errorf("%T: Default: %w", f, err))
+		}
+
+		if f.Default.Just() != f.Just() {
+			errs = append(errs,
+				fmt.Errorf("%T: Default has inconsistent justification", f))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Reset resets any of the Formatters which implement the col.Resetter
+// interface
+func (f *Switch) Reset() {
+	for _, cf := range f.formatters() {
+		if r, ok := cf.(col.Resetter); ok {
+			r.Reset()
+		}
+	}
+}
+
+// SaveState returns the states of any of the Formatters which implement the
+// col.StateSaver interface
+func (f *Switch) SaveState() any {
+	fs := f.formatters()
+	states := make([]any, len(fs))
+
+	for i, cf := range fs {
+		if ss, ok := cf.(col.StateSaver); ok {
+			states[i] = ss.SaveState()
+		}
+	}
+
+	return states
+}
+
+// RestoreState restores the states of any of the Formatters which
+// implement the col.StateSaver interface from a value returned by SaveState
+func (f *Switch) RestoreState(state any) {
+	states, ok := state.([]any)
+	if !ok {
+		return
+	}
+
+	for i, cf := range f.formatters() {
+		if ss, ok := cf.(col.StateSaver); ok && i < len(states) {
+			ss.RestoreState(states[i])
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package colfmt_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSwitchFormatter(t *testing.T) {
+	isErr := func(v any) bool {
+		_, ok := v.(error)
+		return ok
+	}
+
+	sf := colfmt.Switch{
+		Cases: []colfmt.SwitchCase{
+			{Type: reflect.TypeFor[int](), F: &colfmt.Int{W: 5}},
+			{Pred: isErr, F: &colfmt.Any{StrJust: col.Right}},
+		},
+		Default: &colfmt.Any{W: 7, StrJust: col.Right, Verb: 'q'},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("int"),
+			val:    42,
+			expStr: "42",
+		},
+		{
+			ID:     testhelper.MkID("error, predicate"),
+			val:    errors.New("bad"),
+			expStr: "bad",
+		},
+		{
+			ID:     testhelper.MkID("default"),
+			val:    "x",
+			expStr: `"x"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			sf.Formatted(tc.val), tc.expStr)
+	}
+
+	testhelper.DiffInt(t, "Switch", "width", sf.Width(), 7)
+}
+
+func TestSwitchTypeMatching(t *testing.T) {
+	sf := colfmt.Switch{
+		Cases: []colfmt.SwitchCase{
+			{Type: reflect.TypeFor[error](), F: &colfmt.Any{MaxW: 2}},
+			{Type: reflect.TypeFor[int](), F: &colfmt.Int{Verb: 'x'}},
+		},
+		Default: &colfmt.Any{},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("error interface"),
+			val:    errors.New("bad"),
+			expStr: "ba",
+		},
+		{
+			ID:     testhelper.MkID("error interface, other error type"),
+			val:    &strconv.NumError{Func: "F", Num: "n", Err: errors.New("e")},
+			expStr: "st",
+		},
+		{
+			ID:     testhelper.MkID("concrete type"),
+			val:    255,
+			expStr: "ff",
+		},
+		{
+			ID:     testhelper.MkID("nil"),
+			expStr: "<nil>",
+		},
+		{
+			ID:     testhelper.MkID("no match"),
+			val:    "x",
+			expStr: "x",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			sf.Formatted(tc.val), tc.expStr)
+	}
+}
+
+func TestSwitchFormattedE(t *testing.T) {
+	isStr := func(v any) bool {
+		_, ok := v.(string)
+		return ok
+	}
+
+	sf := colfmt.Switch{
+		Cases: []colfmt.SwitchCase{
+			{Pred: isStr, F: &colfmt.Any{StrJust: col.Right}},
+			{Type: reflect.TypeFor[bool](), F: &colfmt.Float{}},
+		},
+		Default: &colfmt.Float{Prec: 1},
+	}
+
+	if _, err := sf.FormattedE(true); err == nil {
+		t.Error("an error was expected from the wrapped Float formatter")
+	}
+
+	for _, v := range []any{"x", 1.25} {
+		if _, err := sf.FormattedE(v); err != nil {
+			t.Errorf("unexpected error formatting %v: %s", v, err)
+		}
+	}
+}
+
+func TestSwitchCheck(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		sf colfmt.Switch
+	}{
+		{
+			ID: testhelper.MkID("good"),
+			sf: colfmt.Switch{
+				Cases: []colfmt.SwitchCase{
+					{Type: reflect.TypeFor[int](), F: &colfmt.Int{}},
+				},
+				Default: &colfmt.String{StrJust: col.Right},
+			},
+		},
+		{
+			ID:     testhelper.MkID("empty"),
+			ExpErr: testhelper.MkExpErr("no Cases or Default have been given"),
+		},
+		{
+			ID: testhelper.MkID("bad cases"),
+			ExpErr: testhelper.MkExpErr(
+				"Cases[0] has no Formatter",
+				"Cases[1]: colfmt.Int: bad Format verb",
+				"Default has inconsistent justification"),
+			sf: colfmt.Switch{
+				Cases: []colfmt.SwitchCase{
+					{},
+					{F: &colfmt.Int{Verb: '😀'}},
+				},
+				Default: &colfmt.String{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, tc.sf.Check(), tc)
+	}
+}
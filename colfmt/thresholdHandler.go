@@ -0,0 +1,151 @@
+package colfmt
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"unicode/utf8"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// ThresholdOp describes the test that a ThresholdRule applies to a value
+type ThresholdOp int
+
+// The ThresholdOp values:
+//
+//	ThreshLT means the rule applies to values less than the rule's Val
+//	ThreshGT means the rule applies to values greater than the rule's Val
+//	ThreshEQ means the rule applies to values equal to the rule's Val
+//	ThreshNaN means the rule applies to NaN values
+//	ThreshPosInf means the rule applies to positive infinite values
+//	ThreshNegInf means the rule applies to negative infinite values
+const (
+	ThreshLT ThresholdOp = iota
+	ThreshGT
+	ThreshEQ
+	ThreshNaN
+	ThreshPosInf
+	ThreshNegInf
+)
+
+// ThresholdRule gives a test to be applied to a value and the way that a
+// value passing the test should be shown. For instance, a rule with an Op
+// of ThreshLT, a Val of 0.01 and a Replace value of "<0.01" will show any
+// value less than 0.01 as "<0.01".
+type ThresholdRule struct {
+	// Op gives the test to be applied
+	Op ThresholdOp
+	// Val gives the value that the value to be shown is compared
+	// against. It is ignored by the ThreshNaN, ThreshPosInf and
+	// ThreshNegInf tests
+	Val float64
+	// Replace gives the value to be shown in place of a value passing the
+	// test
+	Replace string
+	// F, if set, gives a Formatter to be used to show a value passing the
+	// test. It is used in preference to the Replace value
+	F col.Formatter
+}
+
+// matches returns true if the value passes the rule's test
+func (tr ThresholdRule) matches(f64 float64) bool {
+	switch tr.Op {
+	case ThreshLT:
+		return f64 < tr.Val
+	case ThreshGT:
+		return f64 > tr.Val
+	case ThreshEQ:
+		return f64 == tr.Val
+	case ThreshNaN:
+		return math.IsNaN(f64)
+	case ThreshPosInf:
+		return math.IsInf(f64, 1)
+	case ThreshNegInf:
+		return math.IsInf(f64, -1)
+	}
+
+	return false
+}
+
+// ThresholdHdlr encapsulates all the parts needed to support the
+// replacement of values according to their magnitude. The Rules are
+// applied in order and the first rule whose test the value passes
+// determines how the value is shown. The rules are applied to the value as
+// passed to the Formatter so, for instance, the Percent Formatter applies
+// them to the proportion rather than the percentage.
+type ThresholdHdlr struct {
+	// Rules gives the rules to be applied to the value
+	Rules []ThresholdRule
+}
+
+// Threshold returns the replacement for the value, v, and true if it
+// passes the test of one of the Rules. Otherwise it returns the empty
+// string and false.
+//
+// This should be called by the column formatter's Formatted method to decide
+// whether or not to replace the value.
+func (th *ThresholdHdlr) Threshold(v any) (string, bool) {
+	if len(th.Rules) == 0 {
+		return "", false
+	}
+
+	f64, ok := getNumAsFloat64(v)
+	if !ok {
+		return "", false
+	}
+
+	for _, tr := range th.Rules {
+		if !tr.matches(f64) {
+			continue
+		}
+
+		if tr.F != nil {
+			return tr.F.Formatted(v), true
+		}
+
+		return tr.Replace, true
+	}
+
+	return "", false
+}
+
+// thresholdWidth returns the greatest width of any of the replacement
+// values
+func (th ThresholdHdlr) thresholdWidth() int {
+	w := 0
+
+	for _, tr := range th.Rules {
+		if tr.F != nil {
+			w = max(w, tr.F.Width())
+		} else {
+			w = max(w, utf8.RuneCountInString(tr.Replace))
+		}
+	}
+
+	return w
+}
+
+// checkThresholds returns a non-nil error if any of the Rules has an
+// invalid Op or a Formatter which fails its check
+func (th ThresholdHdlr) checkThresholds() error {
+	var errs []error
+
+	for i, tr := range th.Rules {
+		switch tr.Op {
+		case ThreshLT, ThreshGT, ThreshEQ,
+			ThreshNaN, ThreshPosInf, ThreshNegInf:
+		default:
+			errs = append(errs,
+				fmt.Errorf("Rules[%d]: bad ThresholdOp: %d", i, tr.Op))
+		}
+
+		if tr.F != nil {
+			if err := tr.F.Check(); err != nil {
+				errs = append(errs, fmt.Errorf("Rules[%d]: %w", i, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,97 @@
+package colfmt_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestThresholdHdlr(t *testing.T) {
+	th := colfmt.ThresholdHdlr{
+		Rules: []colfmt.ThresholdRule{
+			{Op: colfmt.ThreshEQ, Val: -1, Replace: "n/a"},
+			{Op: colfmt.ThreshNaN, Replace: "NaN"},
+			{Op: colfmt.ThreshPosInf, Replace: "∞"},
+			{Op: colfmt.ThreshGT, Val: 999, Replace: ">999"},
+			{Op: colfmt.ThreshLT, Val: 0, F: &colfmt.Float{Prec: 1}},
+			{Op: colfmt.ThreshLT, Val: 0.01, Replace: "<0.01"},
+		},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		ff     colfmt.Float
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("no rule matches"),
+			val:    1.5,
+			expStr: "1.50",
+		},
+		{
+			ID:     testhelper.MkID("sentinel"),
+			val:    -1,
+			expStr: "n/a",
+		},
+		{
+			ID:     testhelper.MkID("NaN"),
+			val:    math.NaN(),
+			expStr: "NaN",
+		},
+		{
+			ID:     testhelper.MkID("+Inf"),
+			val:    math.Inf(1),
+			expStr: "∞",
+		},
+		{
+			ID:     testhelper.MkID("too big"),
+			val:    1000,
+			expStr: ">999",
+		},
+		{
+			ID:     testhelper.MkID("negative, alternative formatter"),
+			val:    -2.345,
+			expStr: "-2.3",
+		},
+		{
+			ID:     testhelper.MkID("tiny"),
+			val:    0.001,
+			expStr: "<0.01",
+		},
+		{
+			ID: testhelper.MkID("tiny, parens sign"),
+			ff: colfmt.Float{
+				Sign: colfmt.SignParens,
+			},
+			val:    0.001,
+			expStr: "<0.01 ",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.ff.Prec = 2
+		tc.ff.ThresholdHdlr = th
+
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			tc.ff.Formatted(tc.val), tc.expStr)
+	}
+
+	f := colfmt.Float{Prec: 2, ThresholdHdlr: th}
+	testhelper.DiffInt(t, "Float with thresholds", "width", f.Width(), 5)
+
+	pf := colfmt.Percent{ThresholdHdlr: th}
+	testhelper.DiffString(t, "Percent with thresholds", "formatted value",
+		pf.Formatted(0.005), "<0.01")
+
+	badRule := colfmt.Int{
+		ThresholdHdlr: colfmt.ThresholdHdlr{
+			Rules: []colfmt.ThresholdRule{{Op: 99}},
+		},
+	}
+	if err := badRule.Check(); err == nil {
+		t.Errorf("a bad ThresholdOp should fail the Check")
+	}
+}
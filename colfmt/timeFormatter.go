@@ -2,6 +2,7 @@ package colfmt
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/nickwells/col.mod/v6/col"
@@ -12,46 +13,280 @@ import (
 // date is Year/Month/Day not Year/Day/Month
 const DfltTimeFormat = "2006/01/02 15:04:05.000"
 
-// Time records the values needed for the formatting of a time value.
+// TimeMode describes how a Time Formatter will show the time
+type TimeMode int
+
+// The TimeMode values:
+//
+//	TimeAbsolute means the time is shown using the Format
+//	TimeRelative means the time is shown relative to a reference time
+//	TimeHybrid means the time is shown relative to a reference time unless
+//	    it is further away than the AbsoluteAfter duration in which case it
+//	    is shown using the Format
+const (
+	TimeAbsolute TimeMode = iota
+	TimeRelative
+	TimeHybrid
+)
+
+// UnixUnit describes the units in which an integer value passed to a Time
+// Formatter is given
+type UnixUnit int
+
+// The UnixUnit values:
+//
+//	UnixSecs means the value is a number of seconds since the Unix epoch
+//	UnixMillis means the value is a number of milliseconds since the epoch
+//	UnixNanos means the value is a number of nanoseconds since the epoch
+const (
+	UnixSecs UnixUnit = iota
+	UnixMillis
+	UnixNanos
+)
+
+const (
+	// relTimeWidth is the width needed for the widest of the commonly
+	// generated relative time values ("yesterday" or "9999d ago")
+	relTimeWidth = 9
+	// hoursPerDay is the number of hours in a day
+	hoursPerDay = 24
+	// timeNow is the value shown for a relative time which is closer to the
+	// reference time than the Precision
+	timeNow = "now"
+)
+
+// Time records the values needed for the formatting of a time value. As
+// well as time.Time values it will accept a *time.Time, an integer value
+// (taken as a time since the Unix epoch, see the IntUnit field) or a string
+// in RFC 3339 format.
+//
+// See [NilHdlr] for the settings that can be given through that type.
 type Time struct {
 	// W gives the minimum space to be taken by the formatted value
 	W int
 	// Format gives the time format to be used when formatting the value. If
 	// this is not set explicitly then the DfltTimeFmt will be used.
 	Format string
+	// Mode gives the way in which the time will be shown. If this is not
+	// set then the time is shown using the Format.
+	Mode TimeMode
+	// Now returns the reference time against which relative times are
+	// calculated. If this is not set then time.Now is used.
+	Now func() time.Time
+	// Precision gives the smallest difference from the reference time that
+	// will be shown, times closer than this are shown as "now". If this is
+	// not set then a precision of one second is used.
+	Precision time.Duration
+	// AbsoluteAfter gives the difference from the reference time beyond
+	// which the time will be shown using the Format rather than as a
+	// relative time. It is only used if the Mode is TimeHybrid.
+	AbsoluteAfter time.Duration
+	// Location, if set, gives the time zone into which all the times are
+	// converted before they are formatted. If this is not set then the
+	// time is shown in its own location
+	Location *time.Location
+	// IntUnit gives the units of any integer values. If this is not set
+	// then integer values are taken as a number of seconds
+	IntUnit UnixUnit
+	// HandleZeroes, if set to true will check if the time to be printed is
+	// the zero time and if so it will print the ZeroReplacement string
+	// instead
+	HandleZeroes bool
+	// ZeroReplacement is the value to be printed for the zero time if
+	// HandleZeroes is true. For instance, "" or "never"
+	ZeroReplacement string
 
 	NilHdlr
 }
 
-// Formatted returns the value formatted as a time. If the format string is
-// not set then it is set to the DfltTimeFormat.
-func (f *Time) Formatted(v any) string {
-	if f.IgnoreNil && v == nil {
-		return ""
+// now returns the reference time
+func (f Time) now() time.Time {
+	if f.Now == nil {
+		return time.Now()
+	}
+
+	return f.Now()
+}
+
+// precision returns the precision to be used for relative times
+func (f Time) precision() time.Duration {
+	if f.Precision <= 0 {
+		return time.Second
+	}
+
+	return f.Precision
+}
+
+// relDateDiff returns the number of calendar days by which the time t is
+// before the reference time as seen in the location of the reference time
+func relDateDiff(t, ref time.Time) int {
+	t = t.In(ref.Location())
+
+	ty, tm, td := t.Date()
+	ry, rm, rd := ref.Date()
+
+	tDate := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)
+	rDate := time.Date(ry, rm, rd, 0, 0, 0, 0, time.UTC)
+
+	return int(rDate.Sub(tDate).Hours() / hoursPerDay)
+}
+
+// relative returns the time as a value relative to the reference time
+func (f Time) relative(t, ref time.Time) string {
+	diff := ref.Sub(t)
+
+	absDiff := diff.Abs()
+	if absDiff < f.precision() {
+		return timeNow
+	}
+
+	if absDiff >= hoursPerDay*time.Hour {
+		switch relDateDiff(t, ref) {
+		case -1:
+			return "tomorrow"
+		case 1:
+			return "yesterday"
+		}
+	}
+
+	var val string
+
+	switch {
+	case absDiff >= hoursPerDay*time.Hour:
+		val = fmt.Sprintf("%dd", absDiff/(hoursPerDay*time.Hour))
+	case absDiff >= time.Hour:
+		val = fmt.Sprintf("%dh", absDiff/time.Hour)
+	case absDiff >= time.Minute:
+		val = fmt.Sprintf("%dm", absDiff/time.Minute)
+	default:
+		val = fmt.Sprintf("%ds", absDiff/time.Second)
+	}
+
+	if diff < 0 {
+		return "in " + val
+	}
+
+	return val + " ago"
+}
+
+// unixTime converts the integer value into a time according to the IntUnit
+func (f Time) unixTime(i int64) time.Time {
+	switch f.IntUnit {
+	case UnixMillis:
+		return time.UnixMilli(i)
+	case UnixNanos:
+		return time.Unix(0, i)
 	}
 
+	return time.Unix(i, 0)
+}
+
+// getTime converts the value into a time. It returns false if the value
+// cannot be converted. The nil *time.Time is converted into the zero time.
+//
+//nolint:cyclop
+func (f Time) getTime(v any) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, true
+		}
+
+		return *t, true
+	case string:
+		pt, err := time.Parse(time.RFC3339Nano, t)
+		return pt, err == nil
+	case int:
+		return f.unixTime(int64(t)), true
+	case int64:
+		return f.unixTime(t), true
+	case int32:
+		return f.unixTime(int64(t)), true
+	case uint32:
+		return f.unixTime(int64(t)), true
+	case uint64:
+		if t > math.MaxInt64 {
+			return time.Time{}, false
+		}
+
+		return f.unixTime(int64(t)), true
+	}
+
+	return time.Time{}, false
+}
+
+// formatTime returns the time formatted according to the Mode
+func (f *Time) formatTime(t time.Time) string {
+	if f.HandleZeroes && t.IsZero() {
+		return f.ZeroReplacement
+	}
+
+	if f.Location != nil {
+		t = t.In(f.Location)
+	}
+
+	switch f.Mode {
+	case TimeRelative:
+		return f.relative(t, f.now())
+	case TimeHybrid:
+		ref := f.now()
+		if ref.Sub(t).Abs() <= f.AbsoluteAfter {
+			return f.relative(t, ref)
+		}
+	}
+
+	return t.Format(f.format())
+}
+
+// format returns the time format to be used
+func (f Time) format() string {
 	if f.Format == "" {
-		f.Format = DfltTimeFormat
+		return DfltTimeFormat
+	}
+
+	return f.Format
+}
+
+// Formatted returns the value formatted as a time. If the format string is
+// not set then the DfltTimeFormat is used.
+func (f *Time) Formatted(v any) string {
+	s, _ := f.FormattedE(v)
+	return s
+}
+
+// FormattedE returns the value formatted as a time. If the value cannot be
+// converted into a time a non-nil error is returned together with a
+// description of the value.
+func (f *Time) FormattedE(v any) (string, error) {
+	if f.SkipNil(v) {
+		return f.NilReplacement, nil
 	}
 
-	if t, ok := v.(time.Time); ok {
-		return t.Format(f.Format)
+	if t, ok := f.getTime(v); ok {
+		return f.formatTime(t), nil
 	}
 
-	return fmt.Sprintf("Not a time: %v", v)
+	return fmt.Sprintf("Not a time: %v", v),
+		fmt.Errorf("%T: not a time (got: %T): %v", *f, v, v)
 }
 
 // Width returns the intended width of the value. If it is set to zero then
 // the length of the format string is used as a reasonable (but imperfect)
-// value. If the format string is not set then it is set to the
-// DfltTimeFormat before the width is calculated.
-func (f *Time) Width() int {
+// value. If the format string is not set then the length of the
+// DfltTimeFormat is used. For relative times a width sufficient for most
+// relative values is used.
+func (f Time) Width() int {
 	if f.W == 0 {
-		if f.Format == "" {
-			f.Format = DfltTimeFormat
+		switch f.Mode {
+		case TimeRelative:
+			return relTimeWidth
+		case TimeHybrid:
+			return max(relTimeWidth, len(f.format()))
 		}
 
-		f.W = len(f.Format)
+		return len(f.format())
 	}
 
 	return f.W
@@ -62,7 +297,31 @@ func (f Time) Just() col.Justification {
 	return col.Left
 }
 
-// Check returns a nil error
+// Check returns a non-nil error if the Mode or IntUnit is invalid or if any
+// of the durations is negative.
 func (f Time) Check() error {
+	switch f.Mode {
+	case TimeAbsolute, TimeRelative, TimeHybrid:
+	default:
+		return fmt.Errorf("%T: bad Mode: %d", f, f.Mode)
+	}
+
+	switch f.IntUnit {
+	case UnixSecs, UnixMillis, UnixNanos:
+	default:
+		return fmt.Errorf("%T: bad IntUnit: %d", f, f.IntUnit)
+	}
+
+	if f.Precision < 0 {
+		return fmt.Errorf("%T: the Precision (%s) must not be negative",
+			f, f.Precision)
+	}
+
+	if f.AbsoluteAfter < 0 {
+		return fmt.Errorf(
+			"%T: the AbsoluteAfter value (%s) must not be negative",
+			f, f.AbsoluteAfter)
+	}
+
 	return nil
 }
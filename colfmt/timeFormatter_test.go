@@ -0,0 +1,197 @@
+package colfmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTimeFormatter(t *testing.T) {
+	ref := time.Date(2020, time.March, 4, 12, 0, 0, 0, time.UTC)
+	refFunc := func() time.Time { return ref }
+
+	testCases := []struct {
+		testhelper.ID
+		tf     colfmt.Time
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("basic"),
+			val:    ref,
+			expStr: "2020/03/04 12:00:00.000",
+		},
+		{
+			ID:     testhelper.MkID("basic, not a time"),
+			val:    4.2,
+			expStr: "Not a time: 4.2",
+		},
+		{
+			ID:     testhelper.MkID("relative, now"),
+			tf:     colfmt.Time{Mode: colfmt.TimeRelative, Now: refFunc},
+			val:    ref.Add(-500 * time.Millisecond),
+			expStr: "now",
+		},
+		{
+			ID:     testhelper.MkID("relative, seconds ago"),
+			tf:     colfmt.Time{Mode: colfmt.TimeRelative, Now: refFunc},
+			val:    ref.Add(-5 * time.Second),
+			expStr: "5s ago",
+		},
+		{
+			ID: testhelper.MkID("relative, minutes ago, below precision"),
+			tf: colfmt.Time{
+				Mode:      colfmt.TimeRelative,
+				Now:       refFunc,
+				Precision: time.Hour,
+			},
+			val:    ref.Add(-5 * time.Minute),
+			expStr: "now",
+		},
+		{
+			ID:     testhelper.MkID("relative, hours ago"),
+			tf:     colfmt.Time{Mode: colfmt.TimeRelative, Now: refFunc},
+			val:    ref.Add(-3*time.Hour - 20*time.Minute),
+			expStr: "3h ago",
+		},
+		{
+			ID:     testhelper.MkID("relative, in the future"),
+			tf:     colfmt.Time{Mode: colfmt.TimeRelative, Now: refFunc},
+			val:    ref.Add(50 * time.Hour),
+			expStr: "in 2d",
+		},
+		{
+			ID:     testhelper.MkID("relative, yesterday"),
+			tf:     colfmt.Time{Mode: colfmt.TimeRelative, Now: refFunc},
+			val:    ref.Add(-30 * time.Hour),
+			expStr: "yesterday",
+		},
+		{
+			ID:     testhelper.MkID("relative, tomorrow"),
+			tf:     colfmt.Time{Mode: colfmt.TimeRelative, Now: refFunc},
+			val:    ref.Add(25 * time.Hour),
+			expStr: "tomorrow",
+		},
+		{
+			ID: testhelper.MkID("hybrid, recent"),
+			tf: colfmt.Time{
+				Mode:          colfmt.TimeHybrid,
+				Now:           refFunc,
+				AbsoluteAfter: time.Hour,
+			},
+			val:    ref.Add(-59 * time.Minute),
+			expStr: "59m ago",
+		},
+		{
+			ID: testhelper.MkID("hybrid, old"),
+			tf: colfmt.Time{
+				Mode:          colfmt.TimeHybrid,
+				Now:           refFunc,
+				AbsoluteAfter: time.Hour,
+				Format:        time.DateOnly,
+			},
+			val:    ref.Add(-61 * time.Minute),
+			expStr: "2020-03-04",
+		},
+		{
+			ID:     testhelper.MkID("pointer to time"),
+			tf:     colfmt.Time{Format: time.DateOnly},
+			val:    &ref,
+			expStr: "2020-03-04",
+		},
+		{
+			ID: testhelper.MkID("unix seconds, in UTC"),
+			tf: colfmt.Time{
+				Format:   time.DateTime,
+				Location: time.UTC,
+			},
+			val:    ref.Unix(),
+			expStr: "2020-03-04 12:00:00",
+		},
+		{
+			ID: testhelper.MkID("unix millis, in fixed zone"),
+			tf: colfmt.Time{
+				Format:   time.DateTime,
+				Location: time.FixedZone("X", 3600),
+				IntUnit:  colfmt.UnixMillis,
+			},
+			val:    ref.UnixMilli(),
+			expStr: "2020-03-04 13:00:00",
+		},
+		{
+			ID: testhelper.MkID("unix nanos"),
+			tf: colfmt.Time{
+				Format:   time.DateTime,
+				Location: time.UTC,
+				IntUnit:  colfmt.UnixNanos,
+			},
+			val:    ref.UnixNano(),
+			expStr: "2020-03-04 12:00:00",
+		},
+		{
+			ID: testhelper.MkID("RFC 3339 string"),
+			tf: colfmt.Time{
+				Format:   time.DateTime,
+				Location: time.UTC,
+			},
+			val:    "2020-03-04T14:00:00+02:00",
+			expStr: "2020-03-04 12:00:00",
+		},
+		{
+			ID:     testhelper.MkID("bad string"),
+			val:    "nonesuch",
+			expStr: "Not a time: nonesuch",
+		},
+		{
+			ID: testhelper.MkID("zero time"),
+			tf: colfmt.Time{
+				HandleZeroes:    true,
+				ZeroReplacement: "never",
+			},
+			val:    time.Time{},
+			expStr: "never",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.tf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
+
+func TestTimeWidth(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		tf       colfmt.Time
+		expWidth int
+	}{
+		{
+			ID:       testhelper.MkID("default"),
+			expWidth: len(colfmt.DfltTimeFormat),
+		},
+		{
+			ID:       testhelper.MkID("explicit width"),
+			tf:       colfmt.Time{W: 3},
+			expWidth: 3,
+		},
+		{
+			ID:       testhelper.MkID("relative"),
+			tf:       colfmt.Time{Mode: colfmt.TimeRelative},
+			expWidth: 9,
+		},
+		{
+			ID: testhelper.MkID("hybrid"),
+			tf: colfmt.Time{
+				Mode:   colfmt.TimeHybrid,
+				Format: time.Kitchen,
+			},
+			expWidth: 9,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "width", tc.tf.Width(), tc.expWidth)
+	}
+}
@@ -0,0 +1,183 @@
+package colfmt
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// TreeNode holds the details needed to show a row of a hierarchical report
+// using the Tree Formatter. The Depth of a top-level node is zero and IsLast
+// should be set if the node is the last of its siblings.
+type TreeNode struct {
+	Label  string
+	Depth  int
+	IsLast bool
+}
+
+// These are the strings used to draw the tree structure
+const (
+	treeBranch     = "├── "
+	treeLastBranch = "└── "
+	treeStem       = "│   "
+	treeGap        = "    "
+)
+
+// treeLevelWidth is the width taken by each level of the tree
+const treeLevelWidth = 4
+
+// Tree records the values needed for the formatting of a TreeNode so as to
+// show the hierarchy of the rows in a report. The label is preceded either
+// by tree glyphs ("├── ", "└── " and "│   ") or, if Plain is set, by plain
+// indentation. The nodes should be given in pre-order (each node followed
+// by its descendants) as the Formatter records which of the ancestors of
+// the current node have further siblings still to be shown.
+//
+// See [NilHdlr] for the settings that can be given through that type.
+type Tree struct {
+	// W gives the minimum width of the value that should be printed
+	W int
+	// MaxW gives the maximum width of the value, if it is set to zero then
+	// no limit is applied. If it is set to a negative value then the W value
+	// is used. If it is a positive value then that is used. Only the label
+	// is truncated, the tree glyphs or indentation are always shown in full
+	MaxW int
+	// Trunc gives the way in which a label which is too wide is truncated
+	Trunc TruncMode
+	// Plain, if set to true, will indent the label without drawing the tree
+	// glyphs
+	Plain bool
+
+	NilHdlr
+
+	// moreSiblings records, for each level of the tree, whether there are
+	// further nodes to come at that level
+	moreSiblings []bool
+}
+
+// getTreeNode converts the value into a TreeNode if possible
+func getTreeNode(v any) (TreeNode, bool) {
+	switch tn := v.(type) {
+	case TreeNode:
+		return tn, true
+	case *TreeNode:
+		if tn != nil {
+			return *tn, true
+		}
+	}
+
+	return TreeNode{}, false
+}
+
+// maxWidth returns the maximum width of the formatted value or zero if
+// there is no limit
+func (f Tree) maxWidth() int {
+	if f.MaxW < 0 {
+		return max(f.W, 0)
+	}
+
+	return f.MaxW
+}
+
+// prefix returns the glyphs or indentation to be shown before the label of
+// the node. It also records whether or not there are further siblings of
+// the node to come.
+func (f *Tree) prefix(tn TreeNode) string {
+	depth := max(tn.Depth, 0)
+
+	for len(f.moreSiblings) <= depth {
+		f.moreSiblings = append(f.moreSiblings, false)
+	}
+
+	f.moreSiblings = f.moreSiblings[:depth+1]
+	f.moreSiblings[depth] = !tn.IsLast
+
+	if depth == 0 {
+		return ""
+	}
+
+	if f.Plain {
+		return strings.Repeat(treeGap, depth)
+	}
+
+	var b strings.Builder
+
+	for _, more := range f.moreSiblings[1:depth] {
+		if more {
+			b.WriteString(treeStem)
+		} else {
+			b.WriteString(treeGap)
+		}
+	}
+
+	if tn.IsLast {
+		b.WriteString(treeLastBranch)
+	} else {
+		b.WriteString(treeBranch)
+	}
+
+	return b.String()
+}
+
+// Formatted returns the value formatted as a node in a tree
+func (f *Tree) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	tn, ok := getTreeNode(v)
+	if !ok {
+		return fmt.Sprintf("TreeNode expected (got: %T): %v", v, v)
+	}
+
+	prefix := f.prefix(tn)
+
+	label := tn.Label
+	if maxW := f.maxWidth(); maxW > 0 {
+		labelW := max(maxW-utf8.RuneCountInString(prefix), 1)
+		label = f.Trunc.truncate(label, labelW, DfltTruncMarker)
+	}
+
+	return prefix + label
+}
+
+// Width returns the intended width of the value
+func (f Tree) Width() int {
+	return max(f.W, treeLevelWidth)
+}
+
+// Just returns the justification of the value
+func (f Tree) Just() col.Justification {
+	return col.Left
+}
+
+// Check returns a non-nil error if the Trunc value is invalid
+func (f Tree) Check() error {
+	if err := f.Trunc.check(); err != nil {
+		return fmt.Errorf("%T: %w", f, err)
+	}
+
+	return nil
+}
+
+// Reset forgets the structure of the tree shown so far
+func (f *Tree) Reset() {
+	f.moreSiblings = nil
+}
+
+// SaveState returns the structure of the tree shown so far so that it can
+// be restored
+func (f *Tree) SaveState() any {
+	return slices.Clone(f.moreSiblings)
+}
+
+// RestoreState restores the structure of the tree from a value returned by
+// SaveState
+func (f *Tree) RestoreState(state any) {
+	if moreSiblings, ok := state.([]bool); ok {
+		f.moreSiblings = moreSiblings
+	}
+}
@@ -0,0 +1,73 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTreeFormatter(t *testing.T) {
+	nodes := []colfmt.TreeNode{
+		{Label: "root", Depth: 0, IsLast: true},
+		{Label: "bin", Depth: 1},
+		{Label: "ls", Depth: 2, IsLast: true},
+		{Label: "usr", Depth: 1, IsLast: true},
+		{Label: "lib", Depth: 2},
+		{Label: "local", Depth: 2, IsLast: true},
+		{Label: "share", Depth: 3, IsLast: true},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		tf      colfmt.Tree
+		expStrs []string
+	}{
+		{
+			ID: testhelper.MkID("glyphs"),
+			expStrs: []string{
+				"root",
+				"├── bin",
+				"│   └── ls",
+				"└── usr",
+				"    ├── lib",
+				"    └── local",
+				"        └── share",
+			},
+		},
+		{
+			ID: testhelper.MkID("plain"),
+			tf: colfmt.Tree{Plain: true},
+			expStrs: []string{
+				"root",
+				"    bin",
+				"        ls",
+				"    usr",
+				"        lib",
+				"        local",
+				"            share",
+			},
+		},
+		{
+			ID: testhelper.MkID("max width - only the label is truncated"),
+			tf: colfmt.Tree{MaxW: 10, Trunc: colfmt.TruncEnd},
+			expStrs: []string{
+				"root",
+				"├── bin",
+				"│   └── ls",
+				"└── usr",
+				"    ├── l…",
+				"    └── l…",
+				"        └── s",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		for i, n := range nodes {
+			s := tc.tf.Formatted(n)
+			testhelper.DiffString(t, tc.IDStr(), "formatted value",
+				s, tc.expStrs[i])
+		}
+	}
+}
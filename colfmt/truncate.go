@@ -0,0 +1,122 @@
+package colfmt
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nickwells/col.mod/v6/internal/runewidth"
+)
+
+// TruncMode describes how a value which is too wide for its column is
+// truncated
+type TruncMode int
+
+// The TruncMode values:
+//
+//	TruncCut means the end of the value is cut off with no marker
+//	TruncEnd means the end is replaced with a marker: "verylongna…"
+//	TruncStart means the start is replaced with a marker: "…rylongname"
+//	TruncMiddle means the middle is replaced with a marker: "veryl…gname"
+//	TruncPath means the value is taken to be a file path and the middle of
+//	    the path is replaced with a marker so as to keep the basename:
+//	    "/usr/…/file.go". If the basename is too wide it is truncated as
+//	    for TruncStart
+const (
+	TruncCut TruncMode = iota
+	TruncEnd
+	TruncStart
+	TruncMiddle
+	TruncPath
+)
+
+// These are the markers used to show where a value has been truncated
+const (
+	DfltTruncMarker  = "…"
+	ASCIITruncMarker = "..."
+)
+
+// check returns a non-nil error if the TruncMode is invalid
+func (tm TruncMode) check() error {
+	switch tm {
+	case TruncCut, TruncEnd, TruncStart, TruncMiddle, TruncPath:
+		return nil
+	}
+
+	return fmt.Errorf("bad TruncMode: %d", tm)
+}
+
+// truncWidth returns the leading part of the string which is no more than
+// w terminal columns wide
+func truncWidth(s string, w int) string {
+	sw := 0
+
+	for i, r := range s {
+		sw += runewidth.Rune(r)
+		if sw > w {
+			return s[:i]
+		}
+	}
+
+	return s
+}
+
+// lastWidth returns the trailing part of the string which is no more than
+// w terminal columns wide
+func lastWidth(s string, w int) string {
+	sw := 0
+
+	for i := len(s); i > 0; {
+		r, n := utf8.DecodeLastRuneInString(s[:i])
+
+		sw += runewidth.Rune(r)
+		if sw > w {
+			return s[i:]
+		}
+
+		i -= n
+	}
+
+	return s
+}
+
+// truncate returns the string truncated according to the TruncMode so that
+// it is no more than w terminal columns wide, so East Asian wide characters
+// count as two columns. The marker is used to show where the string has
+// been truncated. If the marker is too wide to fit the string is simply
+// cut.
+func (tm TruncMode) truncate(s string, w int, marker string) string {
+	sw := runewidth.String(s)
+	if sw <= w {
+		return s
+	}
+
+	mw := runewidth.String(marker)
+	if tm == TruncCut || mw >= w {
+		return truncWidth(s, w)
+	}
+
+	switch tm {
+	case TruncStart:
+		return marker + lastWidth(s, w-mw)
+	case TruncMiddle:
+		head := truncWidth(s, (w-mw+1)/2) //nolint:mnd
+		return head + marker + lastWidth(s, w-mw-runewidth.String(head))
+	case TruncPath:
+		idx := strings.LastIndex(s, "/")
+		if idx < 0 {
+			return TruncStart.truncate(s, w, marker)
+		}
+
+		base := s[idx:]
+
+		head := w - mw - runewidth.String(base)
+		if head < 0 {
+			return TruncStart.truncate(s, w, marker)
+		}
+
+		return truncWidth(s, head) + marker + base
+	}
+
+	return truncWidth(s, w-mw) + marker
+}
@@ -0,0 +1,158 @@
+package colfmt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/col.mod/v6/col"
+)
+
+// Measurement holds a value and its uncertainty (such as its standard
+// error). It is intended to be used with the Uncertainty Formatter.
+type Measurement struct {
+	Val float64
+	Err float64
+}
+
+// uncertaintySep is the separator between the value and its uncertainty
+const uncertaintySep = " ± "
+
+// Uncertainty records the values needed for the formatting of a value
+// together with its uncertainty, either as "12.34 ± 0.05" or, in the
+// concise notation, as "12.34(5)". The value can be given either as a
+// Measurement (or a pointer to one) or as a [2]float64 holding the value and
+// the uncertainty in that order.
+//
+// The precision with which both the value and the uncertainty are shown is
+// taken from the number of significant figures of the uncertainty to be
+// shown. The value is right-justified and the uncertainty is
+// left-justified within their respective widths so that, provided the
+// widths are big enough, the ± signs (or the opening brackets) line up.
+//
+// See [NilHdlr] for the settings that can be given through that type.
+type Uncertainty struct {
+	// ValW gives the minimum width of the value part
+	ValW int
+	// ErrW gives the minimum width of the uncertainty part. In the concise
+	// notation this does not include the brackets
+	ErrW int
+	// SigFigs gives the number of significant figures of the uncertainty to
+	// be shown. If it is less than 1 then 1 significant figure is shown
+	SigFigs int
+	// Concise, if set to true, will show the uncertainty in the concise
+	// notation, in brackets after the value and in units of the last digit
+	// of the value
+	Concise bool
+
+	NilHdlr
+}
+
+// getMeasurement converts the value into a Measurement if possible
+func getMeasurement(v any) (Measurement, bool) {
+	switch m := v.(type) {
+	case Measurement:
+		return m, true
+	case *Measurement:
+		if m != nil {
+			return *m, true
+		}
+	case [2]float64:
+		return Measurement{Val: m[0], Err: m[1]}, true
+	}
+
+	return Measurement{}, false
+}
+
+// sigFigs returns the number of significant figures of the uncertainty to
+// be shown
+func (f Uncertainty) sigFigs() int {
+	return max(f.SigFigs, 1)
+}
+
+// decimals returns the number of decimal places to be shown for a value
+// with the given uncertainty. This can be negative in which case the values
+// should be rounded to the corresponding power of ten.
+func (f Uncertainty) decimals(errVal float64) int {
+	// format the value so that any rounding up is taken into account
+	s := strconv.FormatFloat(errVal, 'e', f.sigFigs()-1, 64)
+
+	rounded, _ := strconv.ParseFloat(s, 64)
+
+	return f.sigFigs() - 1 - int(math.Floor(math.Log10(rounded)))
+}
+
+// formatAt returns the value formatted to the given number of decimal
+// places. If the number of decimal places is negative the value is rounded
+// to the corresponding power of ten.
+func formatAt(f64 float64, decimals int) string {
+	if decimals < 0 {
+		scale := math.Pow10(-decimals)
+		return strconv.FormatFloat(math.Round(f64/scale)*scale, 'f', 0, 64)
+	}
+
+	return strconv.FormatFloat(f64, 'f', decimals, 64)
+}
+
+// Formatted returns the value formatted with its uncertainty
+func (f *Uncertainty) Formatted(v any) string {
+	if f.SkipNil(v) {
+		return f.NilReplacement
+	}
+
+	m, ok := getMeasurement(v)
+	if !ok {
+		return fmt.Sprintf("Measurement expected (got: %T): %v", v, v)
+	}
+
+	if m.Err <= 0 || math.IsNaN(m.Err) || math.IsInf(m.Err, 0) {
+		return fmt.Sprintf("%*s%s",
+			f.valWidth(), strconv.FormatFloat(m.Val, 'g', -1, 64),
+			strings.Repeat(" ", f.Width()-f.valWidth()))
+	}
+
+	decimals := f.decimals(m.Err)
+	val := formatAt(m.Val, decimals)
+
+	if f.Concise {
+		errDigits := formatAt(m.Err*math.Pow10(max(decimals, 0)),
+			min(decimals, 0))
+
+		return fmt.Sprintf("%*s%-*s",
+			f.valWidth(), val, f.errWidth()+2, "("+errDigits+")") //nolint:mnd
+	}
+
+	return fmt.Sprintf("%*s%s%-*s",
+		f.valWidth(), val, uncertaintySep, f.errWidth(),
+		formatAt(m.Err, decimals))
+}
+
+// valWidth returns the width of the value part
+func (f Uncertainty) valWidth() int {
+	return max(f.ValW, 1)
+}
+
+// errWidth returns the width of the uncertainty part
+func (f Uncertainty) errWidth() int {
+	return max(f.ErrW, 1)
+}
+
+// Width returns the intended width of the value
+func (f Uncertainty) Width() int {
+	if f.Concise {
+		return f.valWidth() + f.errWidth() + 2 //nolint:mnd
+	}
+
+	return f.valWidth() + len([]rune(uncertaintySep)) + f.errWidth()
+}
+
+// Just returns the justification of the value
+func (f Uncertainty) Just() col.Justification {
+	return col.Right
+}
+
+// Check returns a nil error
+func (f Uncertainty) Check() error {
+	return nil
+}
@@ -0,0 +1,84 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestUncertaintyFormatter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		uf     colfmt.Uncertainty
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("basic"),
+			uf:     colfmt.Uncertainty{ValW: 6, ErrW: 4},
+			val:    colfmt.Measurement{Val: 12.3456, Err: 0.05},
+			expStr: " 12.35 ± 0.05",
+		},
+		{
+			ID:     testhelper.MkID("basic, as an array"),
+			uf:     colfmt.Uncertainty{ValW: 6, ErrW: 4},
+			val:    [2]float64{12.3456, 0.0123},
+			expStr: " 12.35 ± 0.01",
+		},
+		{
+			ID:     testhelper.MkID("uncertainty rounds up"),
+			uf:     colfmt.Uncertainty{ValW: 6, ErrW: 4},
+			val:    &colfmt.Measurement{Val: 12.3456, Err: 0.096},
+			expStr: "  12.3 ± 0.1 ",
+		},
+		{
+			ID: testhelper.MkID("two significant figures"),
+			uf: colfmt.Uncertainty{ValW: 6, ErrW: 4, SigFigs: 2},
+			val: colfmt.Measurement{
+				Val: 12.3456,
+				Err: 0.05,
+			},
+			expStr: "12.346 ± 0.050",
+		},
+		{
+			ID:     testhelper.MkID("large uncertainty"),
+			uf:     colfmt.Uncertainty{ValW: 6, ErrW: 4},
+			val:    colfmt.Measurement{Val: 1234.5, Err: 23},
+			expStr: "  1230 ± 20  ",
+		},
+		{
+			ID:     testhelper.MkID("concise"),
+			uf:     colfmt.Uncertainty{ValW: 6, ErrW: 2, Concise: true},
+			val:    colfmt.Measurement{Val: 12.3456, Err: 0.05},
+			expStr: " 12.35(5) ",
+		},
+		{
+			ID: testhelper.MkID("concise, large uncertainty"),
+			uf: colfmt.Uncertainty{
+				ValW:    6,
+				ErrW:    2,
+				Concise: true,
+				SigFigs: 2,
+			},
+			val:    colfmt.Measurement{Val: 1234.5, Err: 23},
+			expStr: "  1234(23)",
+		},
+		{
+			ID:     testhelper.MkID("no uncertainty"),
+			uf:     colfmt.Uncertainty{ValW: 6, ErrW: 4},
+			val:    colfmt.Measurement{Val: 12.5},
+			expStr: "  12.5       ",
+		},
+		{
+			ID:     testhelper.MkID("bad value"),
+			val:    12.5,
+			expStr: "Measurement expected (got: float64): 12.5",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.uf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
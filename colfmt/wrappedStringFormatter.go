@@ -9,7 +9,8 @@ import (
 )
 
 // WrappedString records the values needed for the formatting of a string
-// value.
+// value. Values which are not strings are converted to text in the same way
+// as by the [Any] Formatter.
 //
 // See [NilHdlr] and [DupHdlr] for the settings that can be given through
 // those types.
@@ -33,7 +34,7 @@ type WrappedString struct {
 // trimmed
 func (f *WrappedString) Formatted(v any) string {
 	if f.SkipNil(v) {
-		return ""
+		return f.NilReplacement
 	}
 
 	if f.SkipDup(v) {
@@ -51,7 +52,7 @@ func (f *WrappedString) Formatted(v any) string {
 		twrap.SetMinChars(width),
 		twrap.SetWriter(&b))
 
-	twc.Wrap(v.(string), 0)
+	twc.Wrap(anyAsString(v, 'v'), 0)
 
 	return strings.TrimRight(b.String(), "\n")
 }
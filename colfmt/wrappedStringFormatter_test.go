@@ -0,0 +1,35 @@
+package colfmt_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestWrappedStringFormatter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		wsf    colfmt.WrappedString
+		val    any
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("string"),
+			wsf:    colfmt.WrappedString{W: 5},
+			val:    "hello world",
+			expStr: "hello\nworld",
+		},
+		{
+			ID:     testhelper.MkID("not a string"),
+			wsf:    colfmt.WrappedString{W: 5},
+			val:    []int{1, 2, 3, 4},
+			expStr: "[1 2\n3 4]",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.wsf.Formatted(tc.val)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value", s, tc.expStr)
+	}
+}
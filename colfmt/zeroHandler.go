@@ -1,8 +1,10 @@
 package colfmt
 
-// FloatZeroHandler is a mixin for handling zero values for columns taking
-// floats
-type FloatZeroHandler struct {
+import "math"
+
+// ZeroHandler is a mixin for handling zero values for columns taking
+// numbers. It can be used with values of any of the integer or float types.
+type ZeroHandler struct {
 	// Handle, if set to true will check if the value to be printed is zero
 	// (or closer than the given precision can reveal) and if so it will
 	// print the Replacement string instead. The string printed will not be
@@ -10,30 +12,102 @@ type FloatZeroHandler struct {
 	Handle bool
 	// Replace is the value to be printed for zero if Handle is true
 	Replace string
+	// Epsilon, if greater than zero, overrides the value calculated from
+	// the precision. Any value whose magnitude is no greater than Epsilon
+	// is then treated as zero
+	Epsilon float64
+	// SeparateNearZero, if set to true, will cause values which are treated
+	// as zero but which are not exactly zero to be shown as the
+	// NearZeroReplace string rather than the Replace string
+	SeparateNearZero bool
+	// NearZeroReplace is the value to be printed for values which are
+	// treated as zero but which are not exactly zero if SeparateNearZero is
+	// true. For instance, "~0"
+	NearZeroReplace string
+	// NormaliseNegZero, if set to true, will cause small negative values
+	// which would be shown as zero (such as -0.001 shown to 2 decimal
+	// places) to be shown without a minus sign. This applies even if Handle
+	// is not set
+	NormaliseNegZero bool
+
 	epsilon float64
 }
 
+// FloatZeroHandler is the former name of the ZeroHandler, retained for
+// backwards compatibility.
+//
+// Deprecated: use ZeroHandler.
+type FloatZeroHandler = ZeroHandler
+
 // setEpsilon sets the epsilon value if it hasn't already been set
-func (fzh *FloatZeroHandler) setEpsilon(prec int) {
-	if fzh.epsilon == 0.0 {
-		fzh.epsilon = calcEpsilon(prec)
+func (zh *ZeroHandler) setEpsilon(prec int) {
+	if zh.epsilon == 0.0 {
+		zh.epsilon = calcEpsilon(prec)
+	}
+}
+
+// isZero returns true if the value should be treated as zero when shown
+// with the given precision
+func (zh *ZeroHandler) isZero(prec int, f64 float64) bool {
+	if zh.Epsilon > 0 {
+		return math.Abs(f64) <= zh.Epsilon
+	}
+
+	zh.setEpsilon(prec)
+
+	if prec > 0 {
+		return f64 < zh.epsilon && f64 > (-1*zh.epsilon)
+	}
+
+	return f64 <= zh.epsilon && f64 >= (-1*zh.epsilon)
+}
+
+// replacement returns the string to be shown in place of the (zero) value
+func (zh *ZeroHandler) replacement(f64 float64) string {
+	if zh.SeparateNearZero && f64 != 0 {
+		return zh.NearZeroReplace
 	}
+
+	return zh.Replace
 }
 
 // GetZeroStr calculates the appropriate zero string and returns it with a
 // boolean indicating whether it should be used or not (if the value passed
 // was actually zero)
-func (fzh *FloatZeroHandler) GetZeroStr(prec int, v any) (bool, string) {
-	if fzh != nil && fzh.Handle {
-		fzh.setEpsilon(prec)
-
-		f64, ok := getValAsFloat64(v)
-		if ok &&
-			((prec > 0 && f64 < fzh.epsilon && f64 > (-1*fzh.epsilon)) ||
-				(prec == 0 && f64 <= fzh.epsilon && f64 >= (-1*fzh.epsilon))) {
-			return true, fzh.Replace
+func (zh *ZeroHandler) GetZeroStr(prec int, v any) (bool, string) {
+	if zh != nil && zh.Handle {
+		f64, ok := getNumAsFloat64(v)
+		if ok && zh.isZero(prec, f64) {
+			return true, zh.replacement(f64)
+		}
+	}
+
+	return false, ""
+}
+
+// getExactZeroStr returns the replacement string with a boolean indicating
+// whether it should be used or not. Unlike GetZeroStr it only treats values
+// which are exactly zero (or no greater than any given Epsilon) as zero;
+// this is for use by formatters which show values to a number of
+// significant figures rather than to a fixed precision.
+func (zh *ZeroHandler) getExactZeroStr(v any) (bool, string) {
+	if zh != nil && zh.Handle {
+		f64, ok := getNumAsFloat64(v)
+		if ok && (f64 == 0 || math.Abs(f64) <= zh.Epsilon) {
+			return true, zh.replacement(f64)
 		}
 	}
 
 	return false, ""
 }
+
+// isNegZero returns true if the NormaliseNegZero flag is set and the value
+// is negative but would be shown as zero with the given precision. Such
+// values should be shown as zero without a minus sign.
+func (zh *ZeroHandler) isNegZero(prec int, f64 float64) bool {
+	if zh == nil || !zh.NormaliseNegZero || !math.Signbit(f64) {
+		return false
+	}
+
+	return f64 > -calcEpsilon(max(prec, 0))
+}
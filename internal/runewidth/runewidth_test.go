@@ -0,0 +1,50 @@
+package runewidth_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/internal/runewidth"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRune(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		r        rune
+		expWidth int
+	}{
+		{ID: testhelper.MkID("ASCII"), r: 'a', expWidth: 1},
+		{ID: testhelper.MkID("accented"), r: 'é', expWidth: 1},
+		{ID: testhelper.MkID("ellipsis"), r: '…', expWidth: 1},
+		{ID: testhelper.MkID("combining acute"), r: '\u0301', expWidth: 0},
+		{ID: testhelper.MkID("zero-width joiner"), r: '\u200d', expWidth: 0},
+		{ID: testhelper.MkID("CJK ideograph"), r: '日', expWidth: 2},
+		{ID: testhelper.MkID("katakana"), r: 'テ', expWidth: 2},
+		{ID: testhelper.MkID("hangul"), r: '한', expWidth: 2},
+		{ID: testhelper.MkID("fullwidth A"), r: 'Ａ', expWidth: 2},
+		{ID: testhelper.MkID("emoji"), r: '😀', expWidth: 2},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "width",
+			runewidth.Rune(tc.r), tc.expWidth)
+	}
+}
+
+func TestString(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		s        string
+		expWidth int
+	}{
+		{ID: testhelper.MkID("empty"), s: "", expWidth: 0},
+		{ID: testhelper.MkID("ASCII"), s: "abc", expWidth: 3},
+		{ID: testhelper.MkID("combined"), s: "e\u0301", expWidth: 1},
+		{ID: testhelper.MkID("mixed"), s: "a日b", expWidth: 4},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "width",
+			runewidth.String(tc.s), tc.expWidth)
+	}
+}
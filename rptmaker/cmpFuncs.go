@@ -0,0 +1,13 @@
+package rptmaker
+
+import "github.com/nickwells/col.mod/v6/colfmt"
+
+// MkEnumCmpFunc returns a comparison function suitable for use as the
+// cmpVals function of a [ColInfo]. It compares the values returned by the
+// valF function according to their declared order in the Enum (see the
+// [colfmt.Enum.Cmp] method) rather than by their label text.
+func MkEnumCmpFunc[T any](e *colfmt.Enum, valF ColValFunc[T]) ColCmpFunc[T] {
+	return func(a, b T) int {
+		return e.Cmp(valF(a), valF(b))
+	}
+}
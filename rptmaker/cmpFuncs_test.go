@@ -0,0 +1,25 @@
+package rptmaker_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nickwells/col.mod/v6/colfmt"
+	"github.com/nickwells/col.mod/v6/rptmaker"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMkEnumCmpFunc(t *testing.T) {
+	e := &colfmt.Enum{
+		Labels: map[any]string{1: "one", 2: "two", 3: "three"},
+		Order:  []any{1, 2, 3},
+	}
+	cf := rptmaker.MkEnumCmpFunc(e, func(t T) any { return t.A })
+
+	vals := []T{{A: 3}, {A: 1}, {A: 2}}
+	slices.SortFunc(vals, cf)
+
+	for i, v := range vals {
+		testhelper.DiffInt(t, "sorted by declared order", "value", v.A, i+1)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"github.com/nickwells/col.mod/v6/col"
+	"github.com/nickwells/col.mod/v6/colfmt"
 )
 
 // Report holds the details needed to generate a report
@@ -124,28 +125,63 @@ func (r Report[P, T]) MkCmpFunc(
 	}, nil
 }
 
-// PrintLine gathers the values to be printed from the v supplied using the
-// Report's value functions. It returns a non-nil error if any of the columns
-// is not found in the Report's [Cols], if the [ColInfo] has no value
-// function or if the row printing fails.
-func (r Report[P, T]) PrintLine(v T) error {
+// lineVals gathers the values to be printed from the v supplied using the
+// Report's value functions. It returns a non-nil error if any of the
+// columns is not found in the Report's [Cols] or if the [ColInfo] has no
+// value function.
+func (r Report[P, T]) lineVals(v T) ([]any, error) {
 	vals := make([]any, 0, len(r.colIDs))
 
 	for _, cid := range r.colIDs {
 		ci, ok := r.cols.colMap[cid]
 		if !ok {
-			return MkColNotFoundErr(cid)
+			return nil, MkColNotFoundErr(cid)
 		}
 
 		valF := ci.colVal
 		if valF == nil {
-			return MkNoFuncErr(cid, colValFName)
+			return nil, MkNoFuncErr(cid, colValFName)
 		}
 
 		vals = append(vals, valF(v))
 	}
 
-	return r.rpt.PrintRow(vals...)
+	return vals, nil
+}
+
+// printRow prints the values. If any of the values could not be formatted
+// the error is returned with the ID of the column added.
+func (r Report[P, T]) printRow(vals []any) error {
+	err := r.rpt.PrintRow(vals...)
+
+	var fe col.FormatError
+	if errors.As(err, &fe) && fe.ColIdx < len(r.colIDs) {
+		return fmt.Errorf("column: %q: %w", r.colIDs[fe.ColIdx], err)
+	}
+
+	return err
+}
+
+// PrintLine gathers the values to be printed from the v supplied using the
+// Report's value functions. It returns a non-nil error if any of the columns
+// is not found in the Report's [Cols], if the [ColInfo] has no value
+// function or if the row printing fails. If a value cannot be formatted
+// (see [col.FormatterE]) the error names the column.
+func (r Report[P, T]) PrintLine(v T) error {
+	vals, err := r.lineVals(v)
+	if err != nil {
+		return err
+	}
+
+	return r.printRow(vals)
+}
+
+// ColReport returns the underlying col.Report. This can be used to set the
+// Report's options such as whether rows containing values which cannot be
+// formatted should be printed (see [col.Report.SetFmtErrAbort] and
+// [col.Report.SetFmtErrMarker]).
+func (r Report[P, T]) ColReport() *col.Report {
+	return r.rpt
 }
 
 // Print takes the slice of values, sorts them according to the supplied
@@ -168,3 +204,75 @@ func (r Report[P, T]) Print(vals []T, sortCols []SortColumn) error {
 
 	return nil
 }
+
+// PrintTree prints the tree of values whose top-level nodes are given in
+// roots. The children function returns the children of a node (if any). The
+// nodes are printed in pre-order, each node followed by its descendants, and
+// the children of each node are sorted according to the supplied sortCols.
+//
+// Any column value which is a [colfmt.TreeNode] has its Depth and IsLast
+// fields set from the position of the node in the tree so the value
+// function for such a column need only set the Label. Such columns should
+// use a [colfmt.Tree] Formatter.
+func (r Report[P, T]) PrintTree(
+	roots []T,
+	children func(T) []T,
+	sortCols []SortColumn,
+) error {
+	var cf func(a, b T) int
+
+	if len(sortCols) > 0 {
+		var err error
+
+		cf, err = r.MkCmpFunc(sortCols)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.printSubtrees(roots, 0, children, cf)
+}
+
+// printSubtrees prints each of the nodes at the given depth, in the order
+// given by the cmp function (if any), each followed by its descendants.
+func (r Report[P, T]) printSubtrees(
+	nodes []T,
+	depth int,
+	children func(T) []T,
+	cmp func(a, b T) int,
+) error {
+	if cmp != nil {
+		nodes = slices.Clone(nodes)
+		slices.SortFunc(nodes, cmp)
+	}
+
+	for i, v := range nodes {
+		vals, err := r.lineVals(v)
+		if err != nil {
+			return err
+		}
+
+		for j, val := range vals {
+			if tn, ok := val.(colfmt.TreeNode); ok {
+				tn.Depth = depth
+				tn.IsLast = i == len(nodes)-1
+				vals[j] = tn
+			}
+		}
+
+		if err := r.printRow(vals); err != nil {
+			return err
+		}
+
+		if children == nil {
+			continue
+		}
+
+		err = r.printSubtrees(children(v), depth+1, children, cmp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -67,6 +67,13 @@ var (
 	)
 )
 
+// ciBadFmt shows the string field with an Int Formatter
+var ciBadFmt = rptmaker.NewColInfo(rptmaker.CIDesc, []string{"bad"},
+	func(_ P, h []string) *col.Col { return col.New(&colfmt.Int{}, h...) },
+	func(t T) any { return t.B },
+	nil,
+)
+
 func TestReport_MakeReport(t *testing.T) {
 	const errIntro = "cannot create the Report:"
 
@@ -326,6 +333,7 @@ func TestReport_Print(t *testing.T) {
 		repCols   []rptmaker.ColID
 		sortCols  []rptmaker.SortColumn
 		data      []T
+		abort     bool
 		expReport string
 	}{
 		{
@@ -352,6 +360,19 @@ func TestReport_Print(t *testing.T) {
 				{ID: ciaName},
 			},
 		},
+		{
+			ID: testhelper.MkID("value cannot be formatted"),
+			ExpErr: testhelper.MkExpErr(`column: "bad format":` +
+				` row 1: column[1] (["bad"]): colfmt.Int:` +
+				` integer value expected (got: string): a`),
+			colsToAdd: []ColsAddInfo{
+				{CID: ciaName, CI: cia},
+				{CID: "bad format", CI: ciBadFmt},
+			},
+			repCols: []rptmaker.ColID{ciaName, "bad format"},
+			data:    ts1,
+			abort:   true,
+		},
 		{
 			ID: testhelper.MkID("3 rows of data, 2 columns"),
 			colsToAdd: []ColsAddInfo{
@@ -389,6 +410,8 @@ func TestReport_Print(t *testing.T) {
 				t.Fatal("\t: unexpected error making Report: ", err)
 			}
 
+			r.ColReport().SetFmtErrAbort(tc.abort)
+
 			err = r.Print(tc.data, tc.sortCols)
 			testhelper.CheckExpErr(t, err, tc)
 
@@ -400,3 +423,92 @@ func TestReport_Print(t *testing.T) {
 		})
 	}
 }
+
+func TestReport_PrintTree(t *testing.T) {
+	ciTreeName := rptmaker.ColID("tree")
+	ciTree := rptmaker.NewColInfo(rptmaker.CIDesc, []string{"name"},
+		func(_ P, h []string) *col.Col {
+			return col.New(&colfmt.Tree{W: 10}, h...)
+		},
+		func(t T) any { return colfmt.TreeNode{Label: t.B} },
+		nil,
+	)
+
+	kids := map[string][]T{
+		"root": {{A: 3, B: "c"}, {A: 1, B: "a"}},
+		"a":    {{A: 2, B: "a2"}, {A: 1, B: "a1"}},
+	}
+	children := func(v T) []T { return kids[v.B] }
+	roots := []T{{A: 0, B: "root"}}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		sortCols  []rptmaker.SortColumn
+		expReport string
+	}{
+		{
+			ID: testhelper.MkID("bad sort cols, not found"),
+			ExpErr: testhelper.MkExpErr(
+				`cannot make the comparison function: ` +
+					`column: "nonesuch": not found`),
+			sortCols: []rptmaker.SortColumn{{ID: badCIName}},
+		},
+		{
+			ID: testhelper.MkID("unsorted"),
+			expReport: `           column
+name            A
+====            =
+root            0
+├── c           3
+└── a           1
+    ├── a2      2
+    └── a1      1
+`,
+		},
+		{
+			ID:       testhelper.MkID("siblings sorted"),
+			sortCols: []rptmaker.SortColumn{{ID: ciaName}},
+			expReport: `           column
+name            A
+====            =
+root            0
+├── a           1
+│   ├── a1      1
+│   └── a2      2
+└── c           3
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			b, err := rptmaker.MakeTestCols([]ColsAddInfo{
+				{CID: ciaName, CI: cia},
+				{CID: ciTreeName, CI: ciTree},
+			})
+			if err != nil {
+				t.Log(tc.IDStr())
+				t.Fatal("\t: unexpected error making Cols: ", err)
+			}
+
+			var rptOut strings.Builder
+
+			r, err := (b).MakeReport(P{}, &rptOut,
+				[]rptmaker.ColID{ciTreeName, ciaName})
+			if err != nil {
+				t.Log(tc.IDStr())
+				t.Fatal("\t: unexpected error making Report: ", err)
+			}
+
+			err = r.PrintTree(roots, children, tc.sortCols)
+			testhelper.CheckExpErr(t, err, tc)
+
+			if err == nil {
+				testhelper.DiffString(t,
+					tc.IDStr(), "report",
+					rptOut.String(), tc.expReport)
+			}
+		})
+	}
+}